@@ -95,6 +95,8 @@ Examples:
 
 	cmd.Flags().StringVarP(&opts.status, "status", "s", "", "Show only specified status column")
 	cmd.Flags().StringVarP(&opts.priority, "priority", "p", "", "Filter by priority")
+	_ = cmd.RegisterFlagCompletionFunc("status", completeFieldAliases("status"))
+	_ = cmd.RegisterFlagCompletionFunc("priority", completeFieldAliases("priority"))
 	cmd.Flags().StringVar(&opts.state, "state", "open", "Filter by issue state: open, closed, or all")
 	cmd.Flags().IntVarP(&opts.limit, "limit", "n", 10, "Limit issues per column")
 	cmd.Flags().BoolVar(&opts.noBorder, "no-border", false, "Display without box borders")
@@ -111,7 +113,7 @@ func runBoard(cmd *cobra.Command, opts *boardOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -121,7 +123,7 @@ func runBoard(cmd *cobra.Command, opts *boardOptions) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return runBoardWithDeps(cmd, opts, cfg, client)
 }
@@ -252,6 +254,9 @@ type statusColumn struct {
 	value string
 }
 
+// statusAliasOrder is the workflow order of the common status aliases
+var statusAliasOrder = []string{"backlog", "ready", "in_progress", "in_review", "done"}
+
 // getStatusColumns extracts status columns from config in order
 func getStatusColumns(cfg *config.Config) []statusColumn {
 	var columns []statusColumn
@@ -260,9 +265,7 @@ func getStatusColumns(cfg *config.Config) []statusColumn {
 	if statusField, ok := cfg.Fields["status"]; ok && len(statusField.Values) > 0 {
 		// Note: Go maps are unordered, so we'll use a predefined order
 		// that matches common workflow patterns
-		preferredOrder := []string{"backlog", "ready", "in_progress", "in_review", "done"}
-
-		for _, alias := range preferredOrder {
+		for _, alias := range statusAliasOrder {
 			if value, ok := statusField.Values[alias]; ok {
 				columns = append(columns, statusColumn{alias: alias, value: value})
 			}
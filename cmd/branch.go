@@ -1,25 +1,39 @@
 package cmd
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"path/filepath"
+	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"text/template"
+	"time"
 
 	"github.com/rubrical-studios/gh-pmu/internal/api"
 	"github.com/rubrical-studios/gh-pmu/internal/config"
+	"github.com/rubrical-studios/gh-pmu/internal/ui"
 	"github.com/spf13/cobra"
 )
 
-// parseOwnerRepo extracts owner and repo from the first configured repository
+// parseOwnerRepo extracts owner and repo from the primary repository (defaults.repo,
+// else the first configured one)
 func parseOwnerRepo(cfg *config.Config) (string, string, error) {
 	if len(cfg.Repositories) == 0 {
 		return "", "", fmt.Errorf("no repositories configured")
 	}
-	parts := strings.SplitN(cfg.Repositories[0], "/", 2)
+	parts := strings.SplitN(cfg.DefaultRepository(), "/", 2)
 	if len(parts) != 2 {
-		return "", "", fmt.Errorf("invalid repository format: %s", cfg.Repositories[0])
+		return "", "", fmt.Errorf("invalid repository format: %s", cfg.DefaultRepository())
 	}
 	return parts[0], parts[1], nil
 }
@@ -106,16 +120,24 @@ type branchClient interface {
 	GetOpenIssuesByLabel(owner, repo, label string) ([]api.Issue, error)
 	// GetClosedIssuesByLabel returns closed issues with a specific label
 	GetClosedIssuesByLabel(owner, repo, label string) ([]api.Issue, error)
+	// SearchRepositoryIssues searches issues in a repository
+	SearchRepositoryIssues(owner, repo string, filters api.SearchFilters, limit int) ([]api.Issue, error)
 	// AddIssueToProject adds an issue to a project and returns the item ID
 	AddIssueToProject(projectID, issueID string) (string, error)
 	// SetProjectItemField sets a field value on a project item
 	SetProjectItemField(projectID, itemID, fieldID, value string) error
+	// ClearProjectItemField removes a field value, given by field ID, from a project item
+	ClearProjectItemField(projectID, itemID, fieldID string) error
 	// GetProject returns project details
 	GetProject(owner string, number int) (*api.Project, error)
 	// GetIssueByNumber returns an issue by its number
 	GetIssueByNumber(owner, repo string, number int) (*api.Issue, error)
+	// GetIssueClosers returns the merge state of the pull requests closing each issue
+	GetIssueClosers(owner, repo string, numbers []int) (map[int]api.IssueClosers, error)
 	// GetProjectItemID returns the project item ID for an issue
 	GetProjectItemID(projectID, issueID string) (string, error)
+	// GetProjectField returns the project field with the given name
+	GetProjectField(projectID, fieldName string) (*api.ProjectField, error)
 	// GetProjectItemFieldValue returns the current value of a field on a project item
 	GetProjectItemFieldValue(projectID, itemID, fieldID string) (string, error)
 	// GetProjectItems returns all items in a project with their field values
@@ -124,10 +146,14 @@ type branchClient interface {
 	GetProjectItemsMinimal(projectID string, filter *api.ProjectItemsFilter) ([]api.MinimalProjectItem, error)
 	// GetProjectItemsByIssues returns full project item details for specific issues
 	GetProjectItemsByIssues(projectID string, refs []api.IssueRef) ([]api.ProjectItem, error)
-	// UpdateIssueBody updates an issue's body
-	UpdateIssueBody(issueID, body string) error
+	// GetIssueBody returns the current body of an issue
+	GetIssueBody(issueID string) (string, error)
+	// UpdateIssueBodyIfUnchanged updates an issue's body unless it changed since expectedBody was read
+	UpdateIssueBodyIfUnchanged(issueID, expectedBody, newBody string) error
 	// WriteFile writes content to a file path
 	WriteFile(path, content string) error
+	// ReadFile reads a file; a missing file returns an error wrapping os.ErrNotExist
+	ReadFile(path string) (string, error)
 	// MkdirAll creates a directory and all parents
 	MkdirAll(path string) error
 	// GitAdd stages files to git
@@ -136,19 +162,50 @@ type branchClient interface {
 	CloseIssue(issueID string) error
 	// ReopenIssue reopens a closed issue
 	ReopenIssue(issueID string) error
+	// ArchiveProjectItem archives a project item
+	ArchiveProjectItem(projectID, itemID string) error
 	// GitTag creates an annotated git tag
 	GitTag(tag, message string) error
+	// GitTagSigned creates a GPG-signed annotated git tag
+	GitTagSigned(tag, message string) error
+	// GitTagDate returns the date of a git tag
+	GitTagDate(tag string) (time.Time, error)
+	// GitListTags returns the names of the local git tags
+	GitListTags() ([]string, error)
+	// GitIsClean reports whether the git worktree has no uncommitted changes
+	GitIsClean() (bool, error)
 	// GitCheckoutNewBranch creates and checks out a new git branch
 	GitCheckoutNewBranch(branch string) error
+	// GitCurrentBranch returns the name of the checked-out git branch
+	GitCurrentBranch() (string, error)
+	// GitDeleteBranch deletes a merged local git branch
+	GitDeleteBranch(name string) error
+	// GitDeleteRemoteBranch deletes a branch from origin
+	GitDeleteRemoteBranch(name string) error
+	// GitDeleteTag deletes a local git tag
+	GitDeleteTag(tag string) error
+	// GitDeleteRemoteTag deletes a tag from origin
+	GitDeleteRemoteTag(tag string) error
 	// AddLabelToIssue adds a label to an issue, creating it if needed
 	AddLabelToIssue(owner, repo, issueID, labelName string) error
 	// RemoveLabelFromIssue removes a label from an issue
 	RemoveLabelFromIssue(owner, repo, issueID, labelName string) error
+	// ResolveAssignee returns the login for an assignee argument, with @me
+	// resolved to the authenticated user
+	ResolveAssignee(login string) (string, error)
+	// UpdateIssueTitle updates an issue's title
+	UpdateIssueTitle(issueID, title string) error
+	// GetSubIssueCounts returns the number of sub-issues of each issue
+	GetSubIssueCounts(owner, repo string, numbers []int) (map[int]int, error)
+	// GetSubIssues returns the sub-issues of an issue
+	GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error)
 }
 
 // branchStartOptions holds the options for the branch start command
 type branchStartOptions struct {
 	branchName string
+	force      bool
+	copyFrom   string
 }
 
 // branchAddOptions holds the options for the branch add command
@@ -161,21 +218,127 @@ type branchRemoveOptions struct {
 	issueNumber int
 }
 
+// branchReopenOptions holds the options for the branch reopen command
+type branchReopenOptions struct {
+	branchName   string
+	reassign     bool
+	tagDelete    bool
+	deleteRemote bool
+}
+
+// branchCodenameOptions holds the options for the branch codename command
+type branchCodenameOptions struct {
+	branchName string
+	codename   string
+}
+
 // branchCurrentOptions holds the options for the branch current command
 type branchCurrentOptions struct {
-	refresh bool
+	refresh   bool
+	ics       string
+	groupBy   string
+	showURLs  bool
+	check     bool
+	mdTable   bool
+	estimate  string
+	blockedBy bool
+	sort      string
+	reverse   bool
+	noClosed  bool
+	checklist bool
+	aging     bool
+	compact   bool
+	assignee  string
+	onlyMine  bool
+	diffSince string
+	burndown  bool
+	csv       bool
+	subIssues bool
+	depth     int
+	web       bool
+	jsonLines bool
+	schema    bool
 }
 
 // branchCloseOptions holds the options for the branch close command
 type branchCloseOptions struct {
-	tag        bool
-	yes        bool
-	dryRun     bool
-	branchName string
+	tag             bool
+	yes             bool
+	dryRun          bool
+	branchName      string
+	postWebhook     string
+	checklist       bool
+	force           bool
+	noGit           bool
+	sign            bool
+	archiveItems    bool
+	summaryTemplate string
+	failOnParked    bool
+	changelog       bool
+	deleteBranch    bool
+	deleteRemote    bool
+	allowDirty      bool
+	summaryOnly     bool
+	prompter        checklistPrompter
+}
+
+// checklistPrompter asks the yes/no questions for branch close: the final
+// confirmation and each release checklist item. It is a field on the options
+// so tests can script answers.
+type checklistPrompter interface {
+	Confirm(question string) (bool, error)
+}
+
+// readerPrompter is the default checklistPrompter, reading answers line by line
+type readerPrompter struct {
+	reader *bufio.Reader
+	out    io.Writer
+}
+
+func newReaderPrompter(in io.Reader, out io.Writer) *readerPrompter {
+	return &readerPrompter{reader: bufio.NewReader(in), out: out}
+}
+
+// Confirm prints the question and returns true only for "y" or "yes"
+func (p *readerPrompter) Confirm(question string) (bool, error) {
+	fmt.Fprintf(p.out, "%s (y/n): ", question)
+	line, err := p.reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	response := strings.ToLower(strings.TrimSpace(line))
+	return response == "y" || response == "yes", nil
+}
+
+// Ask prints the question and returns the trimmed answer, or defaultValue
+// when the answer is empty
+func (p *readerPrompter) Ask(question, defaultValue string) (string, error) {
+	if defaultValue != "" {
+		fmt.Fprintf(p.out, "%s [%s]: ", question, defaultValue)
+	} else {
+		fmt.Fprintf(p.out, "%s: ", question)
+	}
+	line, err := p.reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	if answer := strings.TrimSpace(line); answer != "" {
+		return answer, nil
+	}
+	return defaultValue, nil
 }
 
 // branchListOptions holds the options for the branch list command
-type branchListOptions struct{}
+type branchListOptions struct {
+	includeClosed bool
+	mdTable       bool
+	watch         bool
+	interval      time.Duration
+	sinceTag      string
+	json          bool
+	filter        string
+	schema        bool
+}
 
 // newBranchCommand creates the branch command group
 func newBranchCommand() *cobra.Command {
@@ -191,6 +354,7 @@ func newBranchCommand() *cobra.Command {
 	cmd.AddCommand(newBranchCurrentCommand())
 	cmd.AddCommand(newBranchCloseCommand())
 	cmd.AddCommand(newBranchReopenCommand())
+	cmd.AddCommand(newBranchCodenameCommand())
 	cmd.AddCommand(newBranchListCommand())
 
 	return cmd
@@ -209,26 +373,36 @@ The --name flag is required and specifies the branch name to create.
 The branch name is used literally for the tracker title, Branch field,
 and artifact directory.
 
+Start refuses to create a tracker when one with the same title already exists,
+open or closed. Use --force to create an intentional duplicate.
+
+Use --copy-from to carry unfinished work forward: after the tracker is
+created, every OPEN issue in the named branch moves to the new one. Closed
+and Parking Lot issues stay where they are.
+
 Examples:
   gh pmu branch start --name release/v2.0.0
   gh pmu branch start --name patch/v1.9.1
-  gh pmu branch start --name hotfix-auth-bypass`,
+  gh pmu branch start --name hotfix-auth-bypass
+  gh pmu branch start --name release/v1.3.0 --copy-from release/v1.2.0`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cwd, err := os.Getwd()
 			if err != nil {
 				return fmt.Errorf("failed to get current directory: %w", err)
 			}
-			cfg, err := config.LoadFromDirectory(cwd)
+			cfg, err := loadConfig(cwd)
 			if err != nil {
 				return fmt.Errorf("failed to load configuration: %w", err)
 			}
 
-			client := api.NewClient()
+			client := newAPIClient()
 			return runBranchStartWithDeps(cmd, opts, cfg, client)
 		},
 	}
 
 	cmd.Flags().StringVar(&opts.branchName, "name", "", "Branch name to track (required)")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "Create the tracker even if one with the same title exists")
+	cmd.Flags().StringVar(&opts.copyFrom, "copy-from", "", "Move open issues from this branch into the new one")
 	_ = cmd.MarkFlagRequired("name")
 
 	return cmd
@@ -254,11 +428,11 @@ func newBranchAddCommand() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to get current directory: %w", err)
 			}
-			cfg, err := config.LoadFromDirectory(cwd)
+			cfg, err := loadConfig(cwd)
 			if err != nil {
 				return fmt.Errorf("failed to load configuration: %w", err)
 			}
-			client := api.NewClient()
+			client := newAPIClient()
 			return runBranchAddWithDeps(cmd, opts, cfg, client)
 		},
 	}
@@ -286,11 +460,11 @@ func newBranchRemoveCommand() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to get current directory: %w", err)
 			}
-			cfg, err := config.LoadFromDirectory(cwd)
+			cfg, err := loadConfig(cwd)
 			if err != nil {
 				return fmt.Errorf("failed to load configuration: %w", err)
 			}
-			client := api.NewClient()
+			client := newAPIClient()
 			return runBranchRemoveWithDeps(cmd, opts, cfg, client)
 		},
 	}
@@ -305,22 +479,106 @@ func newBranchCurrentCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "current",
 		Short: "Show the active branch",
-		Long:  `Displays details about the currently active branch.`,
+		Long: `Displays details about the currently active branch.
+
+Use --ics to export the branch target date as an iCalendar file. The date
+comes from the tracker's target_date field (when configured in fields) or
+from the tracker's milestone due date.
+
+Use --group-by with a configured field (alias or project field name) to list
+the branch issues bucketed by that field's value.
+
+Use --md-table to list the branch issues as a GitHub-flavored markdown
+table for pasting into comments.
+
+Use --estimate with a number field (e.g. "Story Points") to total the
+branch's estimate and the portion already done. Issues without a value
+count as 0.
+
+Use --blocked-by to flag open branch issues whose body says "Blocked by #N"
+(or "Blocked by owner/repo#N") while that issue is still open. References
+to issues that do not exist are reported as broken.
+
+Use --sort number|title|status|state to list the branch issues in that
+order (--reverse flips it). Status sorts in workflow order for the usual
+aliases (backlog, ready, in_progress, in_review, done), then alphabetically.
+Ties break by issue number.
+
+Use --no-closed to list only the remaining work: closed issues are left out
+of the issue list and --group-by buckets, but still counted in the summary.
+
+Use --assignee <login> to list only the issues assigned to that person (one
+of possibly several assignees); @me is the authenticated user. The summary
+still counts the whole branch. --only-mine is shorthand for --assignee @me.
+
+Use --diff-since <timestamp> (RFC 3339, e.g. 2025-01-01T00:00:00Z) to catch
+up on the branch: issues closed after the timestamp are listed apart from
+those otherwise updated after it.
+
+Use --include-sub-issues to count the sub-issues of branch issues toward the
+done and incomplete totals. Only direct sub-issues count unless --depth asks
+for more levels, and a sub-issue that is itself a branch issue is counted
+once.
+
+Use --burndown to print the number of branch issues still open at the end of
+each day (UTC) from the tracker's creation to today; --csv prints the series
+as date,remaining CSV instead. Issues count by their latest state, so a
+reopened issue is open throughout, and one closed without a close time is
+closed throughout.
+
+Use --export-json-lines to stream the branch as JSON Lines: a first
+{"type":"header",...} line with the branch, tracker and counts, then one
+{"type":"issue",...} line per issue. --sort, --no-closed and --assignee
+apply to the issue lines; the other listing flags cannot be combined with it.
+--check still sets the exit code once every line is written.
+Add --schema to print the JSON Schema of a line instead of the branch.
+
+Use --open-in-browser (-w) to open the tracker issue in your browser instead
+of printing the branch. When no browser can be started, the tracker URL is
+printed instead.
+
+Use --check in CI to fail when the branch still has incomplete issues.
+Parking Lot issues are not counted. The command exits nonzero when
+incomplete issues remain or no branch is active.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cwd, err := os.Getwd()
 			if err != nil {
 				return fmt.Errorf("failed to get current directory: %w", err)
 			}
-			cfg, err := config.LoadFromDirectory(cwd)
+			cfg, err := loadConfig(cwd)
 			if err != nil {
 				return fmt.Errorf("failed to load configuration: %w", err)
 			}
-			client := api.NewClient()
+			client := newAPIClient()
 			return runBranchCurrentWithDeps(cmd, opts, cfg, client)
 		},
 	}
 
 	cmd.Flags().BoolVar(&opts.refresh, "refresh", false, "Update tracker issue body with current issue list")
+	cmd.Flags().StringVar(&opts.ics, "ics", "", "Write the branch target date as an iCalendar file")
+	cmd.Flags().StringVar(&opts.groupBy, "group-by", "", "Group branch issues by a configured field (e.g. status)")
+	_ = cmd.RegisterFlagCompletionFunc("group-by", completeGroupByFields)
+	cmd.Flags().BoolVar(&opts.showURLs, "show-urls", false, "List branch issues with their URLs")
+	cmd.Flags().BoolVar(&opts.check, "check", false, "Exit nonzero if the branch has incomplete non-Parking Lot issues")
+	cmd.Flags().BoolVar(&opts.mdTable, "md-table", false, "List branch issues as a GitHub-flavored markdown table")
+	cmd.Flags().StringVar(&opts.estimate, "estimate", "", "Total a number field (e.g. \"Story Points\") across branch issues")
+	cmd.Flags().StringVar(&opts.sort, "sort", "", "List branch issues sorted by board, number, title, status, or state")
+	cmd.Flags().BoolVar(&opts.reverse, "reverse", false, "Reverse the --sort order")
+	cmd.Flags().BoolVar(&opts.noClosed, "no-closed", false, "List only open branch issues (closed ones still count in the summary)")
+	cmd.Flags().BoolVar(&opts.blockedBy, "blocked-by", false, "Flag branch issues whose \"Blocked by #N\" references are still open")
+	cmd.Flags().StringVar(&opts.assignee, "assignee", "", "List only branch issues assigned to this login (@me for yourself)")
+	cmd.Flags().BoolVar(&opts.onlyMine, "only-mine", false, "List only branch issues assigned to you (same as --assignee @me)")
+	cmd.Flags().BoolVar(&opts.compact, "compact", false, "List branch issues one terse line each: #N [Status] Title @assignee")
+	cmd.Flags().BoolVar(&opts.subIssues, "include-sub-issues", false, "Count sub-issues of branch issues toward the totals")
+	cmd.Flags().IntVar(&opts.depth, "depth", 1, "With --include-sub-issues, how many levels of sub-issues to count")
+	cmd.Flags().StringVar(&opts.diffSince, "diff-since", "", "List branch issues closed or updated since this RFC 3339 timestamp")
+	cmd.Flags().BoolVar(&opts.burndown, "burndown", false, "Print open branch issues remaining at the end of each day since the branch started")
+	cmd.Flags().BoolVar(&opts.csv, "csv", false, "With --burndown, print the series as CSV")
+	cmd.Flags().BoolVar(&opts.aging, "aging", false, "Show a histogram of branch issue ages (<1d, 1-7d, 7-30d, >30d)")
+	cmd.Flags().BoolVar(&opts.checklist, "markdown-checklist-to-body", false, "With --refresh, sync an issue checklist into the tracker body instead of replacing it")
+	cmd.Flags().BoolVarP(&opts.web, "open-in-browser", "w", false, "Open the tracker issue in your browser")
+	cmd.Flags().BoolVar(&opts.jsonLines, "export-json-lines", false, "Print a header line and one JSON object per issue (JSON Lines)")
+	cmd.Flags().BoolVar(&opts.schema, "schema", false, "With --export-json-lines, print the JSON Schema of a line instead")
 
 	return cmd
 }
@@ -340,18 +598,49 @@ will be used. If multiple branches are active, you must specify which one to clo
 Incomplete issues will be moved to backlog with Branch field cleared.
 Release artifacts should be created beforehand using /prepare-release.
 
+Use --no-git on runners without a git worktree: only the GitHub side of the
+close (fields, tracker, webhook) runs, and --tag is rejected.
+
+Parking Lot issues are skipped at close. Use --fail-on-parking-lot to refuse
+the close until they are triaged; --force falls back to skipping them.
+
+Use --generate-changelog to prepend a "## <version> - <date>" section listing
+the done issues to CHANGELOG.md and stage it. A version already in the
+changelog is left alone.
+
+Use --delete-branch to delete the merged local git branch after closing, and
+--delete-remote-branch to delete it from origin as well. The checked-out
+branch cannot be deleted; switch away from it first.
+
+With git.require_clean_worktree set, a close that tags, stages the changelog
+or deletes a branch refuses to run on a dirty worktree. --allow-dirty skips
+the check.
+
+Use --summary-only to render the close summary and write it to the tracker
+without closing anything: no fields change, no issues move, nothing is tagged
+or archived. Without a summary template the built-in summary is used. It
+also works on a branch that is already closed, which must then be named.
+
 Examples:
   gh pmu branch close                    # Uses current branch if only one exists
   gh pmu branch close release/v2.0.0
   gh pmu branch close patch/v1.9.1 --tag
-  gh pmu branch close --yes`,
+  gh pmu branch close --yes
+  gh pmu branch close --checklist        # Confirm release.checklist items first
+  gh pmu branch close --no-git --yes     # API-only close for CI runners
+  gh pmu branch close --archive-items    # Archive finished items off the board
+  gh pmu branch close --generate-changelog
+  gh pmu branch close release/v2.0.0 --delete-remote-branch
+  gh pmu branch close --summary-template .github/close-summary.tmpl
+  gh pmu branch close v2.0.0 --summary-only   # Refresh the tracker's close summary
+  gh pmu branch close --post-webhook https://ci.example.com/hooks/release`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cwd, err := os.Getwd()
 			if err != nil {
 				return fmt.Errorf("failed to get current directory: %w", err)
 			}
-			cfg, err := config.LoadFromDirectory(cwd)
+			cfg, err := loadConfig(cwd)
 			if err != nil {
 				return fmt.Errorf("failed to load configuration: %w", err)
 			}
@@ -361,7 +650,7 @@ Examples:
 				opts.branchName = args[0]
 			} else {
 				// No argument provided - resolve from active releases
-				client := api.NewClient()
+				client := newAPIClient()
 				releaseName, err := resolveCurrentBranch(cfg, client)
 				if err != nil {
 					return err
@@ -369,7 +658,7 @@ Examples:
 				opts.branchName = releaseName
 			}
 
-			client := api.NewClient()
+			client := newAPIClient()
 			return runBranchCloseWithDeps(cmd, opts, cfg, client)
 		},
 	}
@@ -377,6 +666,19 @@ Examples:
 	cmd.Flags().BoolVar(&opts.tag, "tag", false, "Create a git tag for the release")
 	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip confirmation prompt")
 	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Preview what would happen without making changes")
+	cmd.Flags().StringVar(&opts.postWebhook, "post-webhook", "", "POST a JSON close summary to this URL (overrides webhooks.on_close)")
+	cmd.Flags().BoolVar(&opts.checklist, "checklist", false, "Confirm each release.checklist item before closing")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "Close even if a checklist item is declined or --fail-on-parking-lot finds issues")
+	cmd.Flags().BoolVar(&opts.noGit, "no-git", false, "Skip all git operations (for runners without a worktree)")
+	cmd.Flags().BoolVar(&opts.sign, "sign", false, "Create a GPG-signed tag (implies --tag)")
+	cmd.Flags().StringVar(&opts.summaryTemplate, "summary-template", "", "Write a close summary rendered from this text/template file to the tracker (overrides templates.close_summary)")
+	cmd.Flags().BoolVar(&opts.archiveItems, "archive-items", false, "Archive the branch's done and dropped project items after closing")
+	cmd.Flags().BoolVar(&opts.failOnParked, "fail-on-parking-lot", false, "Refuse to close while the branch has Parking Lot issues")
+	cmd.Flags().BoolVar(&opts.changelog, "generate-changelog", false, "Prepend the branch's done issues to CHANGELOG.md and stage it")
+	cmd.Flags().BoolVar(&opts.deleteBranch, "delete-branch", false, "Delete the merged local git branch after closing")
+	cmd.Flags().BoolVar(&opts.deleteRemote, "delete-remote-branch", false, "Also delete the branch from origin (implies --delete-branch)")
+	cmd.Flags().BoolVar(&opts.allowDirty, "allow-dirty", false, "Skip the git.require_clean_worktree check")
+	cmd.Flags().BoolVar(&opts.summaryOnly, "summary-only", false, "Write the close summary to the tracker without closing the branch")
 
 	return cmd
 }
@@ -387,22 +689,66 @@ func newBranchListCommand() *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "list",
-		Short: "List all branches",
-		Long:  `Displays a table of all branches sorted by version.`,
+		Short: "List branches",
+		Long: `Displays a table of active branches sorted by version.
+
+Use --include-closed (or --all) to also list closed branches.
+Use --md-table for a GitHub-flavored markdown table to paste into comments.
+Use --watch on a terminal to redraw the list every --interval (default 30s,
+minimum 10s) until interrupted.
+Use --since-tag to list only branches whose tracker was created after the
+given git tag's date.
+Use --json for machine-readable output, and --json --schema for the JSON
+Schema of that output.
+Use --filter to keep only the branches matching every space-separated
+condition <field><op><value>, where op is =, !=, <, <=, > or >=:
+  version   compared as a version (version>v1.2.0)
+  status    open (or active) or closed; = and != only
+  codename  compared case-insensitively; = and != only
+  tracker   the tracker issue number
+  tagged    true or false; = and != only
+  issues    the number of issues assigned to the branch
+
+The TAGGED column says whether a local git tag named after the branch exists,
+as created by branch close --tag.
+
+Examples:
+  gh pmu branch list
+  gh pmu branch list --all
+  gh pmu branch list --all --since-tag v1.0.0
+  gh pmu branch list --md-table
+  gh pmu branch list --all --json
+  gh pmu branch list --all --filter "status=open version>v1.2.0 issues>5"
+  gh pmu branch list --all --watch --interval 1m`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cwd, err := os.Getwd()
 			if err != nil {
 				return fmt.Errorf("failed to get current directory: %w", err)
 			}
-			cfg, err := config.LoadFromDirectory(cwd)
+			cfg, err := loadConfig(cwd)
 			if err != nil {
 				return fmt.Errorf("failed to load configuration: %w", err)
 			}
-			client := api.NewClient()
+			client := newAPIClient()
+			if opts.watch {
+				return runWatch(cmd, opts.interval, func() error {
+					return runBranchListWithDeps(cmd, opts, cfg, client)
+				})
+			}
 			return runBranchListWithDeps(cmd, opts, cfg, client)
 		},
 	}
 
+	cmd.Flags().BoolVar(&opts.includeClosed, "include-closed", false, "Also list closed branches")
+	cmd.Flags().BoolVar(&opts.includeClosed, "all", false, "Alias for --include-closed")
+	cmd.Flags().BoolVar(&opts.mdTable, "md-table", false, "Output a GitHub-flavored markdown table")
+	cmd.Flags().BoolVar(&opts.watch, "watch", false, "Redraw the list every --interval until interrupted")
+	cmd.Flags().DurationVar(&opts.interval, "interval", defaultWatchInterval, "Refresh interval for --watch")
+	cmd.Flags().StringVar(&opts.sinceTag, "since-tag", "", "List only branches created after this git tag's date")
+	cmd.Flags().StringVar(&opts.filter, "filter", "", "List only branches matching conditions such as \"status=open version>v1.2.0\"")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+	cmd.Flags().BoolVar(&opts.schema, "schema", false, "With --json, print the JSON Schema of the output instead")
+
 	return cmd
 }
 
@@ -415,7 +761,7 @@ func runBranchStartWithDeps(cmd *cobra.Command, opts *branchStartOptions, cfg *c
 	}
 
 	// Check for existing active branch tracker
-	existingIssues, err := client.GetOpenIssuesByLabel(owner, repo, "branch")
+	existingIssues, err := client.GetOpenIssuesByLabel(owner, repo, cfg.GetBranchLabel())
 	if err != nil {
 		return fmt.Errorf("failed to get existing branches: %w", err)
 	}
@@ -426,18 +772,46 @@ func runBranchStartWithDeps(cmd *cobra.Command, opts *branchStartOptions, cfg *c
 		return fmt.Errorf("active branch exists: %s", activeBranch.Title)
 	}
 
-	// Create the git branch
-	err = client.GitCheckoutNewBranch(opts.branchName)
+	// Resolve the start status before creating anything so a bad alias fails cleanly
+	startStatus, err := resolveStartStatus(cfg)
 	if err != nil {
-		return fmt.Errorf("failed to create branch: %w", err)
+		return err
 	}
 
 	// Use branch name for tracker title and Release field
 	title := fmt.Sprintf("Branch: %s", opts.branchName)
-	body := generateBranchTrackerTemplate(opts.branchName)
+	body := generateBranchTrackerTemplate(opts.branchName, cfg.GetBranchLabel())
+
+	// Guard against duplicate trackers that label discovery may have missed
+	if !opts.force {
+		duplicate, err := findTrackerByTitle(client, owner, repo, title)
+		if err != nil {
+			return fmt.Errorf("failed to check for existing trackers: %w", err)
+		}
+		if duplicate != nil {
+			return fmt.Errorf("tracker with this title already exists: #%d (use --force to create anyway)", duplicate.Number)
+		}
+	}
+
+	// The source branch must exist before anything is created
+	if opts.copyFrom != "" {
+		source, err := findBranchTracker(client, owner, repo, opts.copyFrom)
+		if err != nil {
+			return fmt.Errorf("failed to find source branch: %w", err)
+		}
+		if source == nil {
+			return fmt.Errorf("source branch not found: %s", opts.copyFrom)
+		}
+	}
+
+	// Create the git branch
+	err = client.GitCheckoutNewBranch(opts.branchName)
+	if err != nil {
+		return fmt.Errorf("failed to create branch: %w", err)
+	}
 
 	// Create tracker issue with branch label
-	labels := []string{"branch"}
+	labels := []string{cfg.GetBranchLabel()}
 	issue, err := client.CreateIssue(owner, repo, title, body, labels)
 	if err != nil {
 		return fmt.Errorf("failed to create tracker issue: %w", err)
@@ -455,17 +829,14 @@ func runBranchStartWithDeps(cmd *cobra.Command, opts *branchStartOptions, cfg *c
 		return fmt.Errorf("failed to add issue to project: %w", err)
 	}
 
-	// Set status to In Progress
-	statusField, ok := cfg.Fields["status"]
-	if ok {
-		statusValue := statusField.Values["in_progress"]
-		if statusValue == "" {
-			statusValue = "In progress"
-		}
-		err = client.SetProjectItemField(project.ID, itemID, statusField.Field, statusValue)
+	// Set the start status (In progress unless defaults.start_status says otherwise)
+	if statusField, ok := cfg.Fields["status"]; ok {
+		err = client.SetProjectItemField(project.ID, itemID, statusField.Field, startStatus)
 		if err != nil {
 			return fmt.Errorf("failed to set status: %w", err)
 		}
+	} else {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: no status field configured; tracker status not set\n")
 	}
 
 	// Output confirmation
@@ -473,9 +844,120 @@ func runBranchStartWithDeps(cmd *cobra.Command, opts *branchStartOptions, cfg *c
 	fmt.Fprintf(cmd.OutOrStdout(), "Started tracking: %s\n", title)
 	fmt.Fprintf(cmd.OutOrStdout(), "Tracker issue: #%d\n", issue.Number)
 
+	if opts.copyFrom != "" {
+		if err := copyBranchIssues(cmd, cfg, client, project.ID, owner, repo, opts.copyFrom, opts.branchName); err != nil {
+			return err
+		}
+	}
+
+	// Tell chat channels; the branch is already started, so failures only warn
+	notifyChannels(cmd, cfg, branchNotification{
+		title: fmt.Sprintf("Branch %s started", opts.branchName),
+		lines: []string{fmt.Sprintf("Tracker issue #%d", issue.Number)},
+	})
+
+	return nil
+}
+
+// findBranchTracker finds the tracker for branch, open or closed, under
+// either the "Branch: " or legacy "Release: " title. Returns nil when there
+// is none.
+func findBranchTracker(client branchClient, owner, repo, branch string) (*api.Issue, error) {
+	for _, prefix := range []string{"Branch: ", "Release: "} {
+		tracker, err := findTrackerByTitle(client, owner, repo, prefix+branch)
+		if err != nil || tracker != nil {
+			return tracker, err
+		}
+	}
+	return nil, nil
+}
+
+// copyBranchIssues moves the OPEN issues of branch source to target by
+// rewriting their Branch (or legacy Release) field. Parking Lot issues stay.
+func copyBranchIssues(cmd *cobra.Command, cfg *config.Config, client branchClient, projectID, owner, repo, source, target string) error {
+	filter := &api.ProjectItemsFilter{Repository: fmt.Sprintf("%s/%s", owner, repo)}
+	items, err := client.GetProjectItemsMinimal(projectID, filter)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	var copied []int
+	parked := 0
+	for _, item := range items {
+		fieldName := ""
+		for _, fv := range item.FieldValues {
+			if (fv.Field == BranchFieldName || fv.Field == LegacyReleaseFieldName) && fv.Value == source {
+				fieldName = fv.Field
+				break
+			}
+		}
+		if fieldName == "" || item.IssueState != "OPEN" {
+			continue
+		}
+		if isBranchItemParked(cfg, item.FieldValues) {
+			parked++
+			continue
+		}
+
+		itemID, err := client.GetProjectItemID(projectID, item.IssueID)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: could not find project item for #%d: %v\n", item.IssueNumber, err)
+			continue
+		}
+		if err := client.SetProjectItemField(projectID, itemID, fieldName, target); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: could not move #%d: %v\n", item.IssueNumber, err)
+			continue
+		}
+		copied = append(copied, item.IssueNumber)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Copied %d open issue(s) from %s\n", len(copied), source)
+	for _, number := range copied {
+		fmt.Fprintf(cmd.OutOrStdout(), "  #%d\n", number)
+	}
+	if parked > 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "Skipped %d Parking Lot issue(s)\n", parked)
+	}
+
 	return nil
 }
 
+// resolveStartStatus returns the status value for a newly started tracker:
+// the defaults.start_status alias, else the configured in-progress status
+// (see Config.StatusInProgressValue). A configured alias that does not
+// resolve is an error.
+func resolveStartStatus(cfg *config.Config) (string, error) {
+	if _, ok := cfg.Fields["status"]; !ok {
+		return "", nil
+	}
+	if alias := cfg.Defaults.StartStatus; alias != "" {
+		if err := cfg.ValidateFieldValue("status", alias); err != nil {
+			return "", fmt.Errorf("defaults.start_status: %w", err)
+		}
+		return cfg.ResolveFieldValue("status", alias), nil
+	}
+	return cfg.StatusInProgressValue(), nil
+}
+
+// findTrackerByTitle searches open and closed issues for one whose title is
+// exactly title. Returns nil when there is no exact match.
+func findTrackerByTitle(client branchClient, owner, repo, title string) (*api.Issue, error) {
+	filters := api.SearchFilters{
+		State:  "all",
+		Search: fmt.Sprintf("in:title %q", title),
+	}
+	issues, err := client.SearchRepositoryIssues(owner, repo, filters, 0)
+	if err != nil {
+		return nil, err
+	}
+	for i := range issues {
+		if issues[i].Title == title {
+			return &issues[i], nil
+		}
+	}
+	return nil, nil
+}
+
 // isBranchTracker checks if an issue title matches the branch tracker format
 // Supports both "Branch: " (new) and "Release: " (legacy) prefixes
 func isBranchTracker(title string) bool {
@@ -514,7 +996,7 @@ func resolveCurrentBranch(cfg *config.Config, client branchClient) (string, erro
 		return "", err
 	}
 
-	issues, err := client.GetOpenIssuesByLabel(owner, repo, "branch")
+	issues, err := client.GetOpenIssuesByLabel(owner, repo, cfg.GetBranchLabel())
 	if err != nil {
 		return "", fmt.Errorf("failed to get branch issues: %w", err)
 	}
@@ -546,7 +1028,7 @@ func runBranchAddWithDeps(cmd *cobra.Command, opts *branchAddOptions, cfg *confi
 	}
 
 	// Get open release issues
-	issues, err := client.GetOpenIssuesByLabel(owner, repo, "branch")
+	issues, err := client.GetOpenIssuesByLabel(owner, repo, cfg.GetBranchLabel())
 	if err != nil {
 		return fmt.Errorf("failed to get release issues: %w", err)
 	}
@@ -572,8 +1054,8 @@ func runBranchAddWithDeps(cmd *cobra.Command, opts *branchAddOptions, cfg *confi
 		return fmt.Errorf("failed to get project: %w", err)
 	}
 
-	// Get project item ID for the issue
-	itemID, err := client.GetProjectItemID(project.ID, issue.ID)
+	// Get project item ID for the issue, allowing for an item added moments ago
+	itemID, err := getProjectItemIDWithRetry(client, project.ID, issue.ID)
 	if err != nil {
 		return fmt.Errorf("failed to get project item for issue #%d: %w", opts.issueNumber, err)
 	}
@@ -595,6 +1077,26 @@ func runBranchAddWithDeps(cmd *cobra.Command, opts *branchAddOptions, cfg *confi
 	return nil
 }
 
+// projectItemLookupDelays are the waits between branch add's item lookups. An
+// issue added to the project moments before (by create or an auto-add
+// workflow) can briefly read as not found.
+var projectItemLookupDelays = []time.Duration{250 * time.Millisecond, 750 * time.Millisecond}
+
+// getProjectItemIDWithRetry looks up an issue's project item, retrying only
+// not-found errors, once per projectItemLookupDelays entry. Any other error,
+// or a not-found on the last attempt, is returned.
+func getProjectItemIDWithRetry(client branchClient, projectID, issueID string) (string, error) {
+	itemID, err := client.GetProjectItemID(projectID, issueID)
+	for _, delay := range projectItemLookupDelays {
+		if err == nil || !api.IsNotFound(err) {
+			break
+		}
+		time.Sleep(delay)
+		itemID, err = client.GetProjectItemID(projectID, issueID)
+	}
+	return itemID, err
+}
+
 // extractBranchVersion extracts the version from a branch tracker title
 // Supports both "Branch: " and "Release: " (legacy) prefixes
 // e.g., "Branch: v1.2.0" -> "v1.2.0", "Release: v1.2.0 (Phoenix)" -> "v1.2.0"
@@ -618,7 +1120,7 @@ func runBranchRemoveWithDeps(cmd *cobra.Command, opts *branchRemoveOptions, cfg
 	}
 
 	// Get open release issues
-	issues, err := client.GetOpenIssuesByLabel(owner, repo, "branch")
+	issues, err := client.GetOpenIssuesByLabel(owner, repo, cfg.GetBranchLabel())
 	if err != nil {
 		return fmt.Errorf("failed to get release issues: %w", err)
 	}
@@ -656,8 +1158,13 @@ func runBranchRemoveWithDeps(cmd *cobra.Command, opts *branchRemoveOptions, cfg
 		return fmt.Errorf("branch field not configured")
 	}
 
+	field, err := client.GetProjectField(project.ID, branchField.Field)
+	if err != nil {
+		return fmt.Errorf("failed to get branch field: %w", err)
+	}
+
 	// Check current field value (AC-039-3)
-	currentValue, err := client.GetProjectItemFieldValue(project.ID, itemID, branchField.Field)
+	currentValue, err := client.GetProjectItemFieldValue(project.ID, itemID, field.ID)
 	if err != nil {
 		return fmt.Errorf("failed to get current branch field value: %w", err)
 	}
@@ -668,8 +1175,8 @@ func runBranchRemoveWithDeps(cmd *cobra.Command, opts *branchRemoveOptions, cfg
 		return nil
 	}
 
-	// Clear the Branch text field (AC-039-1)
-	err = client.SetProjectItemField(project.ID, itemID, branchField.Field, "")
+	// Clear the Branch field (AC-039-1)
+	err = client.ClearProjectItemField(project.ID, itemID, field.ID)
 	if err != nil {
 		return fmt.Errorf("failed to clear branch field: %w", err)
 	}
@@ -687,16 +1194,35 @@ func runBranchRemoveWithDeps(cmd *cobra.Command, opts *branchRemoveOptions, cfg
 	return nil
 }
 
+// openInBrowser opens a URL in the default browser. Tests replace it.
+var openInBrowser = ui.OpenInBrowser
+
+// openBranchTracker opens the tracker issue in the browser. Without a usable
+// browser (a headless or CI machine) it warns and prints the URL instead.
+func openBranchTracker(cmd *cobra.Command, url string) {
+	if err := openInBrowser(url); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: could not open a browser: %v\n", err)
+		fmt.Fprintln(cmd.OutOrStdout(), url)
+	}
+}
+
 // runBranchCurrentWithDeps is the testable entry point for release current
 // It receives all dependencies as parameters for easy mocking in tests
 func runBranchCurrentWithDeps(cmd *cobra.Command, opts *branchCurrentOptions, cfg *config.Config, client branchClient) error {
+	if opts.schema {
+		if !opts.jsonLines {
+			return fmt.Errorf("--schema requires --export-json-lines")
+		}
+		return writeJSONSchema(cmd.OutOrStdout(), "gh pmu branch current --export-json-lines line", branchJSONLinesSchema())
+	}
+
 	owner, repo, err := parseOwnerRepo(cfg)
 	if err != nil {
 		return err
 	}
 
 	// Get open release issues
-	issues, err := client.GetOpenIssuesByLabel(owner, repo, "branch")
+	issues, err := client.GetOpenIssuesByLabel(owner, repo, cfg.GetBranchLabel())
 	if err != nil {
 		return fmt.Errorf("failed to get release issues: %w", err)
 	}
@@ -704,19 +1230,109 @@ func runBranchCurrentWithDeps(cmd *cobra.Command, opts *branchCurrentOptions, cf
 	// Find active release tracker
 	activeRelease := findActiveBranch(issues)
 	if activeRelease == nil {
+		if opts.check || opts.web {
+			return fmt.Errorf("no active release")
+		}
 		fmt.Fprintf(cmd.OutOrStdout(), "No active release\n")
 		return nil
 	}
 
+	if opts.web {
+		openBranchTracker(cmd, activeRelease.URL)
+		return nil
+	}
+
 	// Extract version from title
 	releaseVersion := extractBranchVersion(activeRelease.Title)
 
+	if opts.sort != "" && !isBranchSortKey(opts.sort) {
+		return fmt.Errorf("invalid --sort %q: use board, number, title, status, or state", opts.sort)
+	}
+	if opts.checklist && !opts.refresh {
+		return fmt.Errorf("--markdown-checklist-to-body requires --refresh")
+	}
+
+	if opts.depth > 1 && !opts.subIssues {
+		return fmt.Errorf("--depth requires --include-sub-issues")
+	}
+	if opts.subIssues && opts.depth < 1 {
+		return fmt.Errorf("--depth must be at least 1")
+	}
+	if opts.csv && !opts.burndown {
+		return fmt.Errorf("--csv requires --burndown")
+	}
+	if opts.jsonLines {
+		for _, conflict := range []struct {
+			flag string
+			set  bool
+		}{
+			{"md-table", opts.mdTable},
+			{"group-by", opts.groupBy != ""},
+			{"compact", opts.compact},
+			{"estimate", opts.estimate != ""},
+			{"blocked-by", opts.blockedBy},
+			{"aging", opts.aging},
+			{"diff-since", opts.diffSince != ""},
+			{"burndown", opts.burndown},
+			{"ics", opts.ics != ""},
+			{"refresh", opts.refresh},
+		} {
+			if conflict.set {
+				return fmt.Errorf("--export-json-lines cannot be combined with --%s", conflict.flag)
+			}
+		}
+	}
+	if opts.burndown && activeRelease.CreatedAt.IsZero() {
+		return fmt.Errorf("--burndown needs the tracker's creation date, which is unavailable")
+	}
+
+	if opts.onlyMine && opts.assignee != "" && opts.assignee != "@me" {
+		return fmt.Errorf("--only-mine cannot be combined with --assignee")
+	}
+	var diffSince time.Time
+	if opts.diffSince != "" {
+		diffSince, err = time.Parse(time.RFC3339, opts.diffSince)
+		if err != nil {
+			return fmt.Errorf("invalid --diff-since %q: use an RFC 3339 timestamp such as 2025-01-01T00:00:00Z", opts.diffSince)
+		}
+	}
+
+	var assignee string
+	if login := opts.assignee; login != "" || opts.onlyMine {
+		if opts.onlyMine {
+			login = "@me"
+		}
+		assignee, err = client.ResolveAssignee(login)
+		if err != nil {
+			return err
+		}
+		assignee = strings.TrimPrefix(assignee, "@")
+	}
+
+	// Resolve the grouping field up front so a typo fails before any project queries
+	var groupField string
+	if opts.groupBy != "" {
+		groupField, err = resolveGroupByField(cfg, opts.groupBy)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Get project to query items
 	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
 	if err != nil {
 		return fmt.Errorf("failed to get project: %w", err)
 	}
 
+	// Resolve the estimate field before reading items so a wrong field fails fast
+	var estimateField string
+	if opts.estimate != "" {
+		estimateField, err = resolveEstimateField(cfg, client, project.ID, opts.estimate)
+		if err != nil {
+			return err
+		}
+	}
+
 	// OPTIMIZATION: Two-phase query to avoid fetching full issue details for non-matching items
 	// Phase 1: Get minimal data (issue ID, number, state, field values) for filtering
 	repoFilter := fmt.Sprintf("%s/%s", owner, repo)
@@ -729,6 +1345,8 @@ func runBranchCurrentWithDeps(cmd *cobra.Command, opts *branchCurrentOptions, cf
 	// Filter items by Branch field matching releaseVersion
 	// Check both "Branch" (new) and "Release" (legacy) field names
 	var matchingRefs []api.IssueRef
+	var matchingItems []api.MinimalProjectItem
+	var doneCount, parkedCount int
 	for _, item := range minimalItems {
 		// Check if this item has a Branch/Release field matching the target version
 		for _, fv := range item.FieldValues {
@@ -741,115 +1359,1226 @@ func runBranchCurrentWithDeps(cmd *cobra.Command, opts *branchCurrentOptions, cf
 						Repo:   parts[1],
 						Number: item.IssueNumber,
 					})
+					matchingItems = append(matchingItems, item)
+					if isBranchItemDone(cfg, item.IssueState, item.FieldValues) {
+						doneCount++
+					} else if isBranchItemParked(cfg, item.FieldValues) {
+						parkedCount++
+					}
 				}
 				break
 			}
 		}
 	}
 
+	// Roll sub-issues into the totals; the --check gate still counts branch issues only
+	totalCount, totalDone := len(matchingRefs), doneCount
+	var subTotal, subDone int
+	if opts.subIssues {
+		subTotal, subDone, err = countBranchSubIssues(client, matchingRefs, opts.depth)
+		if err != nil {
+			return err
+		}
+		totalCount += subTotal
+		totalDone += subDone
+	}
+
+	if opts.jsonLines {
+		issues, err := fetchBranchIssues(client, project.ID, matchingRefs)
+		if err != nil {
+			return err
+		}
+		if opts.sort != "" {
+			sortBranchIssues(cfg, issues, matchingItems, opts.sort, opts.reverse)
+		}
+		if opts.noClosed {
+			var open []api.Issue
+			for _, issue := range issues {
+				if issue.State != "CLOSED" {
+					open = append(open, issue)
+				}
+			}
+			issues = open
+		}
+		if assignee != "" {
+			issues = filterIssuesByAssignee(issues, assignee)
+		}
+		header := branchJSONLHeader{
+			Branch:     releaseVersion,
+			Tracker:    activeRelease.Number,
+			Total:      totalCount,
+			Done:       totalDone,
+			Incomplete: totalCount - totalDone,
+		}
+		if err := writeBranchJSONLines(cmd.OutOrStdout(), header, issues, branchIssueStatuses(cfg, matchingItems)); err != nil {
+			return err
+		}
+		// The gate runs after the lines, so CI gets both the output and the exit code
+		if opts.check {
+			return branchCheckGate(releaseVersion, len(matchingRefs), doneCount, parkedCount)
+		}
+		return nil
+	}
+
 	// Display branch details (AC-036-1)
 	fmt.Fprintf(cmd.OutOrStdout(), "Current Branch: %s\n", releaseVersion)
 	fmt.Fprintf(cmd.OutOrStdout(), "Tracker: #%d\n", activeRelease.Number)
-	fmt.Fprintf(cmd.OutOrStdout(), "Issues: %d\n", len(matchingRefs))
+	fmt.Fprintf(cmd.OutOrStdout(), "Issues: %d (%d done, %d incomplete)\n", totalCount, totalDone, totalCount-totalDone)
+	if opts.subIssues {
+		fmt.Fprintf(cmd.OutOrStdout(), "Including %d sub-issue(s) (%d done)\n", subTotal, subDone)
+	}
+
+	if estimateField != "" {
+		total, done := sumBranchEstimate(cfg, estimateField, matchingItems)
+		fmt.Fprintf(cmd.OutOrStdout(), "%s: %s (%s done, %s remaining)\n", estimateField,
+			formatEstimate(total), formatEstimate(done), formatEstimate(total-done))
+	}
+
+	// --no-closed hides finished work from the lists but not from the counts
+	listedItems := matchingItems
+	allComplete := false
+	if opts.noClosed {
+		listedItems = nil
+		for _, item := range matchingItems {
+			if item.IssueState != "CLOSED" {
+				listedItems = append(listedItems, item)
+			}
+		}
+		if len(listedItems) == 0 && len(matchingItems) > 0 {
+			allComplete = true
+			fmt.Fprintf(cmd.OutOrStdout(), "\nAll %d issues complete\n", len(matchingItems))
+		}
+	}
+
+	if groupField != "" && !allComplete {
+		printBranchIssueGroups(cmd.OutOrStdout(), groupField, listedItems)
+	}
+
+	// Phase 2: Only fetch full details when titles, URLs or bodies are needed
+	var releaseIssues []api.Issue
+	listIssues := opts.showURLs || opts.sort != "" || opts.noClosed || opts.compact || assignee != ""
+	fetchIssues := listIssues || opts.mdTable || opts.blockedBy || opts.aging || opts.diffSince != "" || opts.burndown
+	if fetchIssues {
+		releaseIssues, err = fetchBranchIssues(client, project.ID, matchingRefs)
+		if err != nil {
+			return err
+		}
+	}
+	if opts.sort != "" {
+		sortBranchIssues(cfg, releaseIssues, matchingItems, opts.sort, opts.reverse)
+	}
+	listedIssues := releaseIssues
+	if opts.noClosed {
+		listedIssues = nil
+		for _, issue := range releaseIssues {
+			if issue.State != "CLOSED" {
+				listedIssues = append(listedIssues, issue)
+			}
+		}
+	}
+	noneAssigned := false
+	if assignee != "" {
+		listedIssues = filterIssuesByAssignee(listedIssues, assignee)
+		if len(listedIssues) == 0 && !allComplete {
+			noneAssigned = true
+			if opts.onlyMine {
+				fmt.Fprintf(cmd.OutOrStdout(), "\nYou have no issues in this release\n")
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "\nNo issues for %s\n", assignee)
+			}
+		}
+	}
+	if opts.mdTable && !allComplete && !noneAssigned {
+		headers, rows := branchIssueTable(listedIssues, opts.showURLs)
+		fmt.Fprintln(cmd.OutOrStdout())
+		writeMarkdownTable(cmd.OutOrStdout(), headers, rows)
+	} else if listIssues {
+		if len(listedIssues) > 0 {
+			fmt.Fprintln(cmd.OutOrStdout())
+		}
+		var statuses map[string]string
+		width := 0
+		if opts.compact {
+			statuses = branchIssueStatuses(cfg, matchingItems)
+			width = getTerminalWidth()
+		}
+		for _, issue := range listedIssues {
+			line := fmt.Sprintf("  #%d %s", issue.Number, issue.Title)
+			if opts.compact {
+				line = formatCompactBranchIssue(issue, statuses[branchIssueKey(issue)], width)
+			}
+			if opts.showURLs && issue.URL != "" {
+				line += "  " + issue.URL
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), line)
+		}
+	}
+
+	if opts.blockedBy {
+		blocked, err := findBlockedBranchIssues(client, releaseIssues, owner, repo)
+		if err != nil {
+			return err
+		}
+		printBlockedBranchIssues(cmd.OutOrStdout(), blocked)
+	}
+
+	if opts.aging {
+		printBranchAging(cmd.OutOrStdout(), branchAgingBuckets(releaseIssues, time.Now()))
+	}
+
+	if opts.diffSince != "" {
+		closed, updated := branchChangesSince(releaseIssues, diffSince)
+		printBranchChanges(cmd.OutOrStdout(), opts.diffSince, closed, updated)
+	}
+
+	if opts.burndown {
+		points := branchBurndown(releaseIssues, activeRelease.CreatedAt, time.Now())
+		if err := printBranchBurndown(cmd.OutOrStdout(), points, opts.csv); err != nil {
+			return err
+		}
+	}
+
+	// Export the target date as a calendar event
+	if opts.ics != "" {
+		targetDate, err := resolveBranchTargetDate(cfg, client, project.ID, owner, repo, activeRelease)
+		if err != nil {
+			return err
+		}
+		calendar := generateBranchICS(releaseVersion, targetDate, time.Now())
+		if err := client.WriteFile(opts.ics, calendar); err != nil {
+			return fmt.Errorf("failed to write calendar: %w", err)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Calendar written: %s (%s)\n", opts.ics, targetDate.Format("2006-01-02"))
+	}
 
 	// If refresh flag is set, update tracker issue body (AC-036-3)
-	// Phase 2: Only fetch full details when we need titles for the tracker body
-	if opts.refresh && len(matchingRefs) > 0 {
-		fullItems, err := client.GetProjectItemsByIssues(project.ID, matchingRefs)
+	if opts.refresh {
+		// Read the body first so a concurrent refresh is detected rather than clobbered
+		expectedBody, err := client.GetIssueBody(activeRelease.ID)
 		if err != nil {
-			return fmt.Errorf("failed to get issue details: %w", err)
+			return fmt.Errorf("failed to read tracker body: %w", err)
 		}
 
-		var releaseIssues []api.Issue
-		for _, item := range fullItems {
-			if item.Issue != nil {
-				releaseIssues = append(releaseIssues, *item.Issue)
+		if !fetchIssues {
+			releaseIssues, err = fetchBranchIssues(client, project.ID, matchingRefs)
+			if err != nil {
+				return err
 			}
 		}
 
 		body := generateBranchTrackerBody(releaseIssues)
-		err = client.UpdateIssueBody(activeRelease.ID, body)
-		if err != nil {
-			return fmt.Errorf("failed to update tracker body: %w", err)
+		if opts.checklist {
+			body = setBranchIssueChecklist(expectedBody, releaseIssues)
+		}
+		err = client.UpdateIssueBodyIfUnchanged(activeRelease.ID, expectedBody, body)
+		if errors.Is(err, api.ErrBodyChanged) {
+			return fmt.Errorf("tracker body changed during refresh; re-run with --refresh to retry")
 		}
-		fmt.Fprintf(cmd.OutOrStdout(), "Tracker body updated\n")
-	} else if opts.refresh && len(matchingRefs) == 0 {
-		// No matching issues, update with empty list
-		body := generateBranchTrackerBody(nil)
-		err = client.UpdateIssueBody(activeRelease.ID, body)
 		if err != nil {
 			return fmt.Errorf("failed to update tracker body: %w", err)
 		}
 		fmt.Fprintf(cmd.OutOrStdout(), "Tracker body updated\n")
 	}
 
+	if opts.check {
+		return branchCheckGate(releaseVersion, len(matchingRefs), doneCount, parkedCount)
+	}
+
 	return nil
 }
 
-// generateBranchTrackerBody generates the body content for a release tracker issue
-func generateBranchTrackerBody(issues []api.Issue) string {
-	var sb strings.Builder
-	sb.WriteString("## Issues in this release\n\n")
-	for _, issue := range issues {
-		sb.WriteString(fmt.Sprintf("- #%d %s\n", issue.Number, issue.Title))
+// branchCheckGate fails CI gates while work other than the Parking Lot
+// remains on the branch
+func branchCheckGate(version string, total, done, parked int) error {
+	if blocking := total - done - parked; blocking > 0 {
+		return fmt.Errorf("branch %s has %d incomplete issue(s)", version, blocking)
 	}
-	return sb.String()
+	return nil
 }
 
-// generateBranchTrackerTemplate generates the initial body template for a branch tracker issue
-func generateBranchTrackerTemplate(branchName string) string {
-	return fmt.Sprintf(`> **Branch Tracker Issue**
->
-> This issue tracks the branch %s. It is managed by gh pmu branch commands.
->
-> **Do not manually:**
-> - Close or reopen this issue
-> - Change the title
-> - Remove the %s label
+// branchJSONLHeader is the first line of branch current --export-json-lines
+type branchJSONLHeader struct {
+	Type       string `json:"type"`
+	Branch     string `json:"branch"`
+	Tracker    int    `json:"tracker"`
+	Total      int    `json:"total"`
+	Done       int    `json:"done"`
+	Incomplete int    `json:"incomplete"`
+}
 
-## Commands
+// branchJSONLIssue is an issue line of branch current --export-json-lines
+type branchJSONLIssue struct {
+	Type       string   `json:"type"`
+	Repository string   `json:"repository"`
+	Number     int      `json:"number"`
+	Title      string   `json:"title"`
+	State      string   `json:"state"`
+	Status     string   `json:"status,omitempty"`
+	URL        string   `json:"url,omitempty"`
+	Assignees  []string `json:"assignees,omitempty"`
+}
 
-- %s - Add issues to this branch
-- %s - Remove issues from this branch
-- %s - Close this branch
+// branchJSONLinesSchema describes one line of branch current
+// --export-json-lines: a header or an issue, told apart by type
+func branchJSONLinesSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"oneOf": []interface{}{
+			withConst(jsonSchemaOf(reflect.TypeOf(branchJSONLHeader{})), "type", "header"),
+			withConst(jsonSchemaOf(reflect.TypeOf(branchJSONLIssue{})), "type", "issue"),
+		},
+	}
+}
+
+// writeBranchJSONLines writes the header line, then one line per issue. Each
+// line is a complete JSON object, so consumers can process them one by one.
+func writeBranchJSONLines(w io.Writer, header branchJSONLHeader, issues []api.Issue, statuses map[string]string) error {
+	enc := json.NewEncoder(w)
+	header.Type = "header"
+	if err := enc.Encode(header); err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		line := branchJSONLIssue{
+			Type:       "issue",
+			Repository: issue.Repository.Owner + "/" + issue.Repository.Name,
+			Number:     issue.Number,
+			Title:      issue.Title,
+			State:      issue.State,
+			Status:     statuses[branchIssueKey(issue)],
+			URL:        issue.URL,
+		}
+		for _, a := range issue.Assignees {
+			line.Assignees = append(line.Assignees, a.Login)
+		}
+		if err := enc.Encode(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countBranchSubIssues counts the sub-issues of refs down to depth levels,
+// and how many of them are closed. A sub-issue that is one of refs, or was
+// already reached through another parent, is not counted again.
+func countBranchSubIssues(client branchClient, refs []api.IssueRef, depth int) (total, done int, err error) {
+	key := func(ref api.IssueRef) string {
+		return fmt.Sprintf("%s/%s#%d", ref.Owner, ref.Repo, ref.Number)
+	}
+	seen := make(map[string]bool)
+	for _, ref := range refs {
+		seen[key(ref)] = true
+	}
+
+	parents := refs
+	for level := 0; level < depth && len(parents) > 0; level++ {
+		parents, err = withSubIssues(client, parents)
+		if err != nil {
+			return 0, 0, err
+		}
+		var next []api.IssueRef
+		for _, parent := range parents {
+			subIssues, err := client.GetSubIssues(parent.Owner, parent.Repo, parent.Number)
+			if err != nil {
+				return 0, 0, fmt.Errorf("failed to get sub-issues of %s: %w", key(parent), err)
+			}
+			for _, sub := range subIssues {
+				ref := api.IssueRef{Owner: sub.Repository.Owner, Repo: sub.Repository.Name, Number: sub.Number}
+				if ref.Owner == "" {
+					ref.Owner, ref.Repo = parent.Owner, parent.Repo
+				}
+				if seen[key(ref)] {
+					continue
+				}
+				seen[key(ref)] = true
+				total++
+				if sub.State == "CLOSED" {
+					done++
+				}
+				next = append(next, ref)
+			}
+		}
+		parents = next
+	}
+	return total, done, nil
+}
+
+// withSubIssues narrows refs to the issues that have sub-issues, batching
+// the count lookups per repository
+func withSubIssues(client branchClient, refs []api.IssueRef) ([]api.IssueRef, error) {
+	type repoKey struct{ owner, repo string }
+	var keys []repoKey
+	numbers := make(map[repoKey][]int)
+	for _, ref := range refs {
+		key := repoKey{ref.Owner, ref.Repo}
+		if _, ok := numbers[key]; !ok {
+			keys = append(keys, key)
+		}
+		numbers[key] = append(numbers[key], ref.Number)
+	}
+
+	var parents []api.IssueRef
+	for _, key := range keys {
+		counts, err := client.GetSubIssueCounts(key.owner, key.repo, numbers[key])
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sub-issue counts for %s/%s: %w", key.owner, key.repo, err)
+		}
+		for _, number := range numbers[key] {
+			if counts[number] > 0 {
+				parents = append(parents, api.IssueRef{Owner: key.owner, Repo: key.repo, Number: number})
+			}
+		}
+	}
+	return parents, nil
+}
+
+// filterIssuesByAssignee keeps the issues with login among their assignees
+func filterIssuesByAssignee(issues []api.Issue, login string) []api.Issue {
+	var matched []api.Issue
+	for _, issue := range issues {
+		for _, a := range issue.Assignees {
+			if strings.EqualFold(a.Login, login) {
+				matched = append(matched, issue)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// fetchBranchIssues loads full issue details (titles, URLs) for branch issues
+func fetchBranchIssues(client branchClient, projectID string, refs []api.IssueRef) ([]api.Issue, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	fullItems, err := client.GetProjectItemsByIssues(projectID, refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue details: %w", err)
+	}
+	var issues []api.Issue
+	for _, item := range fullItems {
+		if item.Issue != nil {
+			issues = append(issues, *item.Issue)
+		}
+	}
+	return issues, nil
+}
+
+// blockedByRegex matches "blocked by #N" and "blocked by owner/repo#N"
+var blockedByRegex = regexp.MustCompile(`(?i)blocked\s+by\s+(?:([\w.-]+)/([\w.-]+))?#(\d+)`)
+
+// blockedBranchIssue is a branch issue with blocker references that are
+// still open or do not resolve
+type blockedBranchIssue struct {
+	issue  api.Issue
+	open   []string // Open blockers, as "#N" or "owner/repo#N"
+	broken []string // References to issues that do not exist
+}
+
+// parseBlockedByRefs returns the distinct "blocked by" references in body.
+// References without a repository point at owner/repo.
+func parseBlockedByRefs(body, owner, repo string) []api.IssueRef {
+	var refs []api.IssueRef
+	seen := make(map[api.IssueRef]bool)
+	for _, m := range blockedByRegex.FindAllStringSubmatch(body, -1) {
+		number, err := strconv.Atoi(m[3])
+		if err != nil {
+			continue
+		}
+		ref := api.IssueRef{Owner: owner, Repo: repo, Number: number}
+		if m[1] != "" {
+			ref.Owner, ref.Repo = m[1], m[2]
+		}
+		if !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// findBlockedBranchIssues resolves the "blocked by" references of each open
+// branch issue and returns those with open or broken references. Each
+// referenced issue is looked up once, however many issues cite it.
+func findBlockedBranchIssues(client branchClient, issues []api.Issue, owner, repo string) ([]blockedBranchIssue, error) {
+	states := make(map[api.IssueRef]string) // "" marks a broken reference
+	var blocked []blockedBranchIssue
+	for _, issue := range issues {
+		if issue.State == "CLOSED" {
+			continue
+		}
+		issueOwner, issueRepo := owner, repo
+		if issue.Repository.Owner != "" && issue.Repository.Name != "" {
+			issueOwner, issueRepo = issue.Repository.Owner, issue.Repository.Name
+		}
+
+		entry := blockedBranchIssue{issue: issue}
+		for _, ref := range parseBlockedByRefs(issue.Body, issueOwner, issueRepo) {
+			state, ok := states[ref]
+			if !ok {
+				blocker, err := client.GetIssueByNumber(ref.Owner, ref.Repo, ref.Number)
+				if err != nil && !api.IsNotFound(err) {
+					return nil, fmt.Errorf("failed to resolve blocker %s/%s#%d: %w", ref.Owner, ref.Repo, ref.Number, err)
+				}
+				if blocker != nil {
+					state = blocker.State
+				}
+				states[ref] = state
+			}
+
+			name := fmt.Sprintf("#%d", ref.Number)
+			if ref.Owner != issueOwner || ref.Repo != issueRepo {
+				name = fmt.Sprintf("%s/%s#%d", ref.Owner, ref.Repo, ref.Number)
+			}
+			switch state {
+			case "":
+				entry.broken = append(entry.broken, name)
+			case "CLOSED":
+			default:
+				entry.open = append(entry.open, name)
+			}
+		}
+		if len(entry.open) > 0 || len(entry.broken) > 0 {
+			blocked = append(blocked, entry)
+		}
+	}
+	return blocked, nil
+}
+
+// printBlockedBranchIssues lists the branch issues flagged by --blocked-by
+func printBlockedBranchIssues(w io.Writer, blocked []blockedBranchIssue) {
+	fmt.Fprintln(w)
+	if len(blocked) == 0 {
+		fmt.Fprintln(w, "Blocked: none")
+		return
+	}
+	fmt.Fprintf(w, "Blocked (%d):\n", len(blocked))
+	for _, b := range blocked {
+		var reasons []string
+		if len(b.open) > 0 {
+			reasons = append(reasons, "blocked by "+strings.Join(b.open, ", "))
+		}
+		if len(b.broken) > 0 {
+			reasons = append(reasons, "broken reference "+strings.Join(b.broken, ", "))
+		}
+		fmt.Fprintf(w, "  #%d %s (%s)\n", b.issue.Number, b.issue.Title, strings.Join(reasons, "; "))
+	}
+}
+
+// branchIssueKey identifies an issue as "owner/repo#N", matching the
+// repository and number of its project item
+func branchIssueKey(issue api.Issue) string {
+	return fmt.Sprintf("%s/%s#%d", issue.Repository.Owner, issue.Repository.Name, issue.Number)
+}
+
+// branchIssueStatuses maps each item's branchIssueKey to its Status value
+func branchIssueStatuses(cfg *config.Config, items []api.MinimalProjectItem) map[string]string {
+	statusField := "Status"
+	if f, ok := cfg.Fields["status"]; ok && f.Field != "" {
+		statusField = f.Field
+	}
+	statuses := make(map[string]string)
+	for _, item := range items {
+		for _, fv := range item.FieldValues {
+			if fv.Field == statusField {
+				statuses[fmt.Sprintf("%s#%d", item.Repository, item.IssueNumber)] = fv.Value
+				break
+			}
+		}
+	}
+	return statuses
+}
+
+// formatCompactBranchIssue renders an issue for --compact as
+// "#42 [In Review] Fix login bug @alice". The status and assignee segments
+// are omitted when empty. With width > 0 the title is truncated so the line
+// fits.
+func formatCompactBranchIssue(issue api.Issue, status string, width int) string {
+	prefix := fmt.Sprintf("#%d ", issue.Number)
+	if status != "" {
+		prefix += "[" + status + "] "
+	}
+	var suffix string
+	for _, a := range issue.Assignees {
+		suffix += " @" + a.Login
+	}
+
+	title := issue.Title
+	if width > 0 {
+		// Keep at least a few characters of the title on narrow terminals
+		title = truncateString(title, max(width-len(prefix)-len(suffix), 4))
+	}
+	return prefix + title + suffix
+}
+
+// branchAgingLabels are the --aging buckets in display order
+var branchAgingLabels = []string{"<1d", "1-7d", "7-30d", ">30d", "unknown"}
+
+// branchAgingBuckets counts issues per age bucket, keyed by the labels in
+// branchAgingLabels. An open issue's age runs from creation to now; a closed
+// one's stops at closedAt when known. Issues without createdAt are unknown.
+func branchAgingBuckets(issues []api.Issue, now time.Time) map[string]int {
+	counts := make(map[string]int)
+	const day = 24 * time.Hour
+	for _, issue := range issues {
+		if issue.CreatedAt.IsZero() {
+			counts["unknown"]++
+			continue
+		}
+		end := now
+		if issue.State == "CLOSED" && !issue.ClosedAt.IsZero() {
+			end = issue.ClosedAt
+		}
+		switch age := end.Sub(issue.CreatedAt); {
+		case age < day:
+			counts["<1d"]++
+		case age < 7*day:
+			counts["1-7d"]++
+		case age < 30*day:
+			counts["7-30d"]++
+		default:
+			counts[">30d"]++
+		}
+	}
+	return counts
+}
+
+// printBranchAging prints the --aging histogram. The unknown bucket is only
+// shown when some issue lacks a creation time.
+func printBranchAging(w io.Writer, counts map[string]int) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Aging:")
+	for _, label := range branchAgingLabels {
+		if label == "unknown" && counts[label] == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "  %-8s %d\n", label, counts[label])
+	}
+}
+
+// branchChangesSince splits out the issues that changed after since: those
+// closed after it, and those otherwise updated after it. Issues without an
+// updatedAt are left out.
+func branchChangesSince(issues []api.Issue, since time.Time) (closed, updated []api.Issue) {
+	for _, issue := range issues {
+		switch {
+		case issue.State == "CLOSED" && issue.ClosedAt.After(since):
+			closed = append(closed, issue)
+		case issue.UpdatedAt.After(since):
+			updated = append(updated, issue)
+		}
+	}
+	return closed, updated
+}
+
+// printBranchChanges prints the --diff-since buckets, or a single line when
+// nothing changed
+func printBranchChanges(w io.Writer, since string, closed, updated []api.Issue) {
+	fmt.Fprintln(w)
+	if len(closed) == 0 && len(updated) == 0 {
+		fmt.Fprintf(w, "No changes since %s\n", since)
+		return
+	}
+	if len(closed) > 0 {
+		fmt.Fprintf(w, "Closed since %s:\n", since)
+		for _, issue := range closed {
+			fmt.Fprintf(w, "  #%d %s\n", issue.Number, issue.Title)
+		}
+	}
+	if len(updated) > 0 {
+		fmt.Fprintf(w, "Updated since %s:\n", since)
+		for _, issue := range updated {
+			fmt.Fprintf(w, "  #%d %s\n", issue.Number, issue.Title)
+		}
+	}
+}
+
+// burndownPoint is the number of branch issues open at the end of a day
+type burndownPoint struct {
+	date      time.Time
+	remaining int
+}
+
+// branchBurndown counts, for each UTC day from start to now, the issues still
+// open at the end of that day. Issues count by their latest state: an open
+// issue is open every day, and a closed one without closedAt is closed
+// every day.
+func branchBurndown(issues []api.Issue, start, now time.Time) []burndownPoint {
+	const day = 24 * time.Hour
+	first := start.UTC().Truncate(day)
+	last := now.UTC().Truncate(day)
+
+	var points []burndownPoint
+	for date := first; !date.After(last); date = date.Add(day) {
+		end := date.Add(day)
+		remaining := 0
+		for _, issue := range issues {
+			if issue.State != "CLOSED" || (!issue.ClosedAt.IsZero() && !issue.ClosedAt.Before(end)) {
+				remaining++
+			}
+		}
+		points = append(points, burndownPoint{date: date, remaining: remaining})
+	}
+	return points
+}
+
+// printBranchBurndown prints the --burndown series as a date/remaining table,
+// or as CSV with a date,remaining header
+func printBranchBurndown(w io.Writer, points []burndownPoint, asCSV bool) error {
+	if asCSV {
+		cw := csv.NewWriter(w)
+		_ = cw.Write([]string{"date", "remaining"})
+		for _, p := range points {
+			_ = cw.Write([]string{p.date.Format("2006-01-02"), strconv.Itoa(p.remaining)})
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Burndown:")
+	for _, p := range points {
+		fmt.Fprintf(w, "  %s  %d\n", p.date.Format("2006-01-02"), p.remaining)
+	}
+	return nil
+}
+
+// isBranchSortKey reports whether key is a valid --sort value
+func isBranchSortKey(key string) bool {
+	switch key {
+	case "board", "number", "title", "status", "state":
+		return true
+	}
+	return false
+}
+
+// sortBranchIssues orders issues by key, reversed when reverse is set. Status
+// values and board positions come from items, which are fetched in board
+// order; ties always break by ascending issue number.
+func sortBranchIssues(cfg *config.Config, issues []api.Issue, items []api.MinimalProjectItem, key string, reverse bool) {
+	statuses := branchIssueStatuses(cfg, items)
+	positions := make(map[string]int)
+	for i, item := range items {
+		positions[fmt.Sprintf("%s#%d", item.Repository, item.IssueNumber)] = i
+	}
+	statusOf := func(issue api.Issue) string {
+		return statuses[branchIssueKey(issue)]
+	}
+	positionOf := func(issue api.Issue) int {
+		return positions[branchIssueKey(issue)]
+	}
+
+	// Statuses behind the common workflow aliases sort in workflow order
+	ranks := make(map[string]int)
+	for i, alias := range statusAliasOrder {
+		if value, ok := cfg.Fields["status"].Values[alias]; ok {
+			ranks[value] = i
+		}
+	}
+	compareStatus := func(a, b string) int {
+		rankA, okA := ranks[a]
+		rankB, okB := ranks[b]
+		switch {
+		case okA && okB:
+			return rankA - rankB
+		case okA:
+			return -1
+		case okB:
+			return 1
+		case a == "" || b == "":
+			// Issues without a status sort last
+			return strings.Compare(b, a)
+		}
+		return strings.Compare(a, b)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		var c int
+		switch key {
+		case "board":
+			c = positionOf(issues[i]) - positionOf(issues[j])
+		case "title":
+			c = strings.Compare(strings.ToLower(issues[i].Title), strings.ToLower(issues[j].Title))
+		case "status":
+			c = compareStatus(statusOf(issues[i]), statusOf(issues[j]))
+		case "state":
+			c = strings.Compare(issues[i].State, issues[j].State)
+		}
+		if reverse {
+			c = -c
+		}
+		if c != 0 {
+			return c < 0
+		}
+		if reverse && key == "number" {
+			return issues[i].Number > issues[j].Number
+		}
+		return issues[i].Number < issues[j].Number
+	})
+}
+
+// branchGroupNone is the heading for issues with no value in the grouping field
+const branchGroupNone = "(none)"
+
+// resolveGroupByField maps a --group-by argument to a project field name. Both
+// config aliases ("status") and project field names ("Status") are accepted, as
+// are the built-in pseudo-fields __milestone and __repository.
+func resolveGroupByField(cfg *config.Config, name string) (string, error) {
+	if name == api.MilestoneFieldName || name == api.RepositoryFieldName {
+		return name, nil
+	}
+	for alias, field := range cfg.Fields {
+		if strings.EqualFold(alias, name) || strings.EqualFold(field.Field, name) {
+			if field.Field == "" {
+				return alias, nil
+			}
+			return field.Field, nil
+		}
+	}
+
+	aliases := make([]string, 0, len(cfg.Fields))
+	for alias := range cfg.Fields {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	if len(aliases) == 0 {
+		return "", fmt.Errorf("unknown field %q: no fields configured", name)
+	}
+	return "", fmt.Errorf("unknown field %q\nConfigured fields: %s (or %s, %s)", name, strings.Join(aliases, ", "),
+		api.MilestoneFieldName, api.RepositoryFieldName)
+}
+
+// resolveEstimateField maps an --estimate argument (config alias or project
+// field name) to a project field name, and checks that it is a number field.
+func resolveEstimateField(cfg *config.Config, client branchClient, projectID, name string) (string, error) {
+	fieldName := name
+	for alias, field := range cfg.Fields {
+		if strings.EqualFold(alias, name) && field.Field != "" {
+			fieldName = field.Field
+			break
+		}
+	}
+
+	field, err := client.GetProjectField(projectID, fieldName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get estimate field: %w", err)
+	}
+	if field.DataType != "NUMBER" {
+		return "", fmt.Errorf("field %s is not a number field", field.Name)
+	}
+	return field.Name, nil
+}
+
+// sumBranchEstimate totals the estimate field across branch items, and
+// separately across the done ones. Items without a value count as 0.
+func sumBranchEstimate(cfg *config.Config, fieldName string, items []api.MinimalProjectItem) (total, done float64) {
+	for _, item := range items {
+		for _, fv := range item.FieldValues {
+			if fv.Field != fieldName {
+				continue
+			}
+			points, err := strconv.ParseFloat(fv.Value, 64)
+			if err != nil {
+				break
+			}
+			total += points
+			if isBranchItemDone(cfg, item.IssueState, item.FieldValues) {
+				done += points
+			}
+			break
+		}
+	}
+	return total, done
+}
+
+// formatEstimate prints whole estimates without a decimal point
+func formatEstimate(points float64) string {
+	return strconv.FormatFloat(points, 'f', -1, 64)
+}
+
+// printBranchIssueGroups prints branch issues bucketed by a field's value.
+// Groups are sorted by value with "(none)" last.
+func printBranchIssueGroups(w io.Writer, fieldName string, items []api.MinimalProjectItem) {
+	groups := make(map[string][]int)
+	for _, item := range items {
+		value := branchGroupNone
+		for _, fv := range item.FieldValues {
+			if fv.Field == fieldName && fv.Value != "" {
+				value = fv.Value
+				break
+			}
+		}
+		groups[value] = append(groups[value], item.IssueNumber)
+	}
+
+	values := make([]string, 0, len(groups))
+	for value := range groups {
+		if value != branchGroupNone {
+			values = append(values, value)
+		}
+	}
+	sort.Strings(values)
+	if _, ok := groups[branchGroupNone]; ok {
+		values = append(values, branchGroupNone)
+	}
+
+	fmt.Fprintf(w, "\nBy %s:\n", fieldName)
+	for _, value := range values {
+		numbers := groups[value]
+		refs := make([]string, len(numbers))
+		for i, n := range numbers {
+			refs[i] = fmt.Sprintf("#%d", n)
+		}
+		fmt.Fprintf(w, "  %s (%d): %s\n", value, len(numbers), strings.Join(refs, ", "))
+	}
+}
+
+// resolveBranchTargetDate finds the branch target date from the tracker's
+// target_date project field, falling back to the tracker milestone's due date
+func resolveBranchTargetDate(cfg *config.Config, client branchClient, projectID, owner, repo string, tracker *api.Issue) (time.Time, error) {
+	var raw string
+	if field, ok := cfg.Fields["target_date"]; ok && field.Field != "" {
+		projectField, err := client.GetProjectField(projectID, field.Field)
+		if err == nil {
+			if itemID, err := client.GetProjectItemID(projectID, tracker.ID); err == nil {
+				raw, _ = client.GetProjectItemFieldValue(projectID, itemID, projectField.ID)
+			}
+		}
+	}
+	if raw == "" {
+		issue, err := client.GetIssueByNumber(owner, repo, tracker.Number)
+		if err == nil && issue != nil && issue.Milestone != nil {
+			raw = issue.Milestone.DueOn
+		}
+	}
+	if raw == "" {
+		return time.Time{}, fmt.Errorf("no target date configured")
+	}
+
+	if date, err := time.Parse("2006-01-02", raw); err == nil {
+		return date, nil
+	}
+	date, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid target date %q", raw)
+	}
+	return date.UTC(), nil
+}
+
+// generateBranchICS builds a minimal iCalendar document with a single all-day
+// event on the target date. Lines end with CRLF as required by RFC 5545.
+func generateBranchICS(branchName string, targetDate, now time.Time) string {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//gh-pmu//branch current//EN",
+		"BEGIN:VEVENT",
+		"UID:" + escapeICSText(branchName) + "@gh-pmu",
+		"DTSTAMP:" + now.UTC().Format("20060102T150405Z"),
+		"DTSTART;VALUE=DATE:" + targetDate.Format("20060102"),
+		"DTEND;VALUE=DATE:" + targetDate.AddDate(0, 0, 1).Format("20060102"),
+		"SUMMARY:" + escapeICSText(branchName),
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
+
+// escapeICSText escapes characters that are special in iCalendar TEXT values
+func escapeICSText(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+	return replacer.Replace(s)
+}
+
+// runBranchChecklist confirms each checklist item in order. A declined item
+// aborts the close unless --force is set; --yes confirms every item.
+func runBranchChecklist(cmd *cobra.Command, opts *branchCloseOptions, prompter checklistPrompter, items []string) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), "Release checklist:")
+	var declined []string
+	for _, item := range items {
+		if opts.yes {
+			fmt.Fprintf(cmd.OutOrStdout(), "  ✓ %s (auto-confirmed)\n", item)
+			continue
+		}
+		ok, err := prompter.Confirm("  " + item + "?")
+		if err != nil {
+			return fmt.Errorf("failed to read checklist answer: %w", err)
+		}
+		if !ok {
+			declined = append(declined, item)
+		}
+	}
+	fmt.Fprintln(cmd.OutOrStdout())
+
+	if len(declined) == 0 {
+		return nil
+	}
+	if opts.force {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: closing with %d declined checklist item(s) (--force)\n", len(declined))
+		return nil
+	}
+	return fmt.Errorf("checklist not satisfied: %s", strings.Join(declined, ", "))
+}
+
+// isBranchItemDone reports whether a branch item counts as done. Closed issues
+// are always done; open issues are done when their Status is in
+// fields.status.semantics.done_values.
+func isBranchItemDone(cfg *config.Config, state string, fieldValues []api.FieldValue) bool {
+	if strings.EqualFold(state, "CLOSED") {
+		return true
+	}
+	statusFieldName := cfg.GetFieldName("status")
+	if statusFieldName == "status" {
+		statusFieldName = "Status"
+	}
+	for _, fv := range fieldValues {
+		if fv.Field == statusFieldName {
+			return cfg.IsDoneStatus(fv.Value)
+		}
+	}
+	return false
+}
+
+// isIssueDropped reports whether an issue was closed as not planned
+func isIssueDropped(issue api.Issue) bool {
+	return strings.EqualFold(issue.State, "CLOSED") && issue.StateReason == api.StateReasonNotPlanned
+}
+
+// isBranchItemParked reports whether a branch item's status is the Parking Lot
+func isBranchItemParked(cfg *config.Config, fieldValues []api.FieldValue) bool {
+	statusFieldName := cfg.GetFieldName("status")
+	if statusFieldName == "status" {
+		statusFieldName = "Status"
+	}
+	parkingLotValue := cfg.StatusParkingLotValue()
+	for _, fv := range fieldValues {
+		if fv.Field == statusFieldName {
+			return fv.Value == parkingLotValue
+		}
+	}
+	return false
+}
+
+// generateBranchTrackerBody generates the body content for a release tracker issue
+func generateBranchTrackerBody(issues []api.Issue) string {
+	var sb strings.Builder
+	sb.WriteString("## Issues in this release\n\n")
+	for _, issue := range issues {
+		sb.WriteString(fmt.Sprintf("- #%d %s\n", issue.Number, issue.Title))
+	}
+	return sb.String()
+}
+
+// branchChecklistStart and branchChecklistEnd delimit the issue checklist in
+// the tracker body, so a refresh replaces only what it wrote
+const (
+	branchChecklistStart = "<!-- pmu:issues -->"
+	branchChecklistEnd   = "<!-- /pmu:issues -->"
+)
+
+// branchChecklistRegex matches the issue checklist section between its markers
+var branchChecklistRegex = regexp.MustCompile(`(?s)` + regexp.QuoteMeta(branchChecklistStart) + `.*?` + regexp.QuoteMeta(branchChecklistEnd))
+
+// setBranchIssueChecklist writes an "## Issues" checklist of issues into body,
+// checking off closed ones. An existing checklist is replaced in place between
+// its markers; otherwise the section is appended. Content outside the markers
+// is left untouched.
+func setBranchIssueChecklist(body string, issues []api.Issue) string {
+	var sb strings.Builder
+	sb.WriteString(branchChecklistStart + "\n## Issues\n\n")
+	for _, issue := range issues {
+		box := " "
+		if issue.State == "CLOSED" {
+			box = "x"
+		}
+		sb.WriteString(fmt.Sprintf("- [%s] #%d %s\n", box, issue.Number, issue.Title))
+	}
+	sb.WriteString(branchChecklistEnd)
+	section := sb.String()
+
+	if branchChecklistRegex.MatchString(body) {
+		return branchChecklistRegex.ReplaceAllLiteralString(body, section)
+	}
+	body = strings.TrimRight(body, "\n")
+	if body == "" {
+		return section
+	}
+	return body + "\n\n" + section
+}
+
+// generateBranchTrackerTemplate generates the initial body template for a
+// branch tracker issue carrying label
+func generateBranchTrackerTemplate(branchName, label string) string {
+	return fmt.Sprintf(`> **Branch Tracker Issue**
+>
+> This issue tracks the branch %s. It is managed by gh pmu branch commands.
+>
+> **Do not manually:**
+> - Close or reopen this issue
+> - Change the title
+> - Remove the %s label
+
+## Commands
+
+- %s - Add issues to this branch
+- %s - Remove issues from this branch
+- %s - Close this branch
 
 ## Issues in this branch
 
 _Issues are tracked via the Branch field in the project._
 `,
 		"`"+branchName+"`",
-		"`branch`",
+		"`"+label+"`",
 		"`gh pmu branch add <issue>`",
 		"`gh pmu branch remove <issue>`",
 		"`gh pmu branch close "+branchName+"`",
 	)
 }
 
+// branchCloseFieldChanges describes the field updates branch close makes on
+// each incomplete issue it moves to backlog, for the dry-run plan.
+func branchCloseFieldChanges(cfg *config.Config) []string {
+	var changes []string
+	if branchField, ok := cfg.Fields["branch"]; ok {
+		changes = append(changes, fmt.Sprintf("clear %s", branchField.Field))
+	}
+	if statusField, ok := cfg.Fields["status"]; ok {
+		changes = append(changes, fmt.Sprintf("set %s to %s", statusField.Field, cfg.StatusBacklogValue()))
+	}
+	return changes
+}
+
+// matchBranchTracker returns the tracker among issues for the named branch,
+// matching both "Branch: " and legacy "Release: " titles, or nil
+func matchBranchTracker(issues []api.Issue, branchName string) *api.Issue {
+	expectedTitleNew := fmt.Sprintf("Branch: %s", branchName)
+	expectedTitleLegacy := fmt.Sprintf("Release: %s", branchName)
+	for i := range issues {
+		title := issues[i].Title
+		if title == expectedTitleNew || strings.HasPrefix(title, expectedTitleNew+" (") ||
+			title == expectedTitleLegacy || strings.HasPrefix(title, expectedTitleLegacy+" (") {
+			return &issues[i]
+		}
+	}
+	return nil
+}
+
+// writeBranchSummaryOnly writes the rendered close summary to the tracker for
+// branch close --summary-only, leaving the branch, its issues and git alone
+func writeBranchSummaryOnly(cmd *cobra.Command, opts *branchCloseOptions, client branchClient, tracker *api.Issue, summary string) error {
+	if opts.dryRun {
+		fmt.Fprintf(cmd.OutOrStdout(), "Would write close summary to tracker #%d:\n%s\n", tracker.Number, summary)
+		return nil
+	}
+	body, err := client.GetIssueBody(tracker.ID)
+	if err != nil {
+		return fmt.Errorf("failed to read tracker body: %w", err)
+	}
+	if err := client.UpdateIssueBodyIfUnchanged(tracker.ID, body, setBranchCloseSummary(body, summary)); err != nil {
+		return fmt.Errorf("failed to write close summary to tracker: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Close summary written to tracker #%d\n", tracker.Number)
+	return nil
+}
+
 // runBranchCloseWithDeps is the testable entry point for release close
 // It receives all dependencies as parameters for easy mocking in tests
 func runBranchCloseWithDeps(cmd *cobra.Command, opts *branchCloseOptions, cfg *config.Config, client branchClient) error {
+	if opts.summaryOnly {
+		for _, conflict := range []struct {
+			flag string
+			set  bool
+		}{
+			{"tag", opts.tag},
+			{"sign", opts.sign},
+			{"generate-changelog", opts.changelog},
+			{"delete-branch", opts.deleteBranch || opts.deleteRemote},
+			{"archive-items", opts.archiveItems},
+		} {
+			if conflict.set {
+				return fmt.Errorf("--summary-only cannot be combined with --%s", conflict.flag)
+			}
+		}
+	}
+	if opts.noGit && opts.sign {
+		return fmt.Errorf("cannot sign a tag with --no-git")
+	}
+	if opts.noGit && opts.tag {
+		return fmt.Errorf("cannot tag with --no-git")
+	}
+	if opts.deleteRemote {
+		opts.deleteBranch = true
+	}
+	if opts.noGit && opts.deleteBranch {
+		return fmt.Errorf("cannot delete a branch with --no-git")
+	}
+	if opts.sign {
+		opts.tag = true
+	}
+	signTag := opts.sign || cfg.Release.RequireSignedTag
+
+	// Only a close that writes to git needs a clean worktree
+	writesGit := !opts.noGit && (opts.tag || opts.changelog || opts.deleteBranch)
+	if writesGit && cfg.RequiresCleanWorktree() && !opts.allowDirty {
+		clean, err := client.GitIsClean()
+		if err != nil {
+			return fmt.Errorf("failed to check git worktree: %w", err)
+		}
+		if !clean {
+			return fmt.Errorf("worktree not clean; commit or stash changes (or pass --allow-dirty)")
+		}
+	}
+
+	// Refuse to delete the checked-out branch before making any changes
+	if opts.deleteBranch {
+		current, err := client.GitCurrentBranch()
+		if err != nil {
+			return fmt.Errorf("failed to get current git branch: %w", err)
+		}
+		if current == opts.branchName {
+			return fmt.Errorf("cannot delete the checked-out branch %s", opts.branchName)
+		}
+	}
+
+	// Parse the summary template up front so a template error fails before any changes
+	summaryPath := opts.summaryTemplate
+	if summaryPath == "" {
+		summaryPath = cfg.GetCloseSummaryTemplate()
+	}
+	var summaryTmpl *template.Template
+	if summaryPath != "" {
+		var err error
+		summaryTmpl, err = loadCloseSummaryTemplate(summaryPath)
+		if err != nil {
+			return err
+		}
+	}
+	if opts.summaryOnly && summaryTmpl == nil {
+		summaryTmpl = defaultCloseSummaryTemplate
+	}
+
 	owner, repo, err := parseOwnerRepo(cfg)
 	if err != nil {
 		return err
 	}
 
 	// Get open release issues
-	issues, err := client.GetOpenIssuesByLabel(owner, repo, "branch")
+	issues, err := client.GetOpenIssuesByLabel(owner, repo, cfg.GetBranchLabel())
 	if err != nil {
 		return fmt.Errorf("failed to get release issues: %w", err)
-	}
-
-	// Find the specified branch by name (supports both "Branch: " and "Release: " formats)
-	var targetBranch *api.Issue
-	expectedTitleNew := fmt.Sprintf("Branch: %s", opts.branchName)
-	expectedTitleLegacy := fmt.Sprintf("Release: %s", opts.branchName)
-	for i := range issues {
-		title := issues[i].Title
-		if title == expectedTitleNew || strings.HasPrefix(title, expectedTitleNew+" (") ||
-			title == expectedTitleLegacy || strings.HasPrefix(title, expectedTitleLegacy+" (") {
-			targetBranch = &issues[i]
-			break
+	}
+
+	targetBranch := matchBranchTracker(issues, opts.branchName)
+	if targetBranch == nil && opts.summaryOnly {
+		// A summary can be refreshed after the branch has closed
+		closed, err := client.GetClosedIssuesByLabel(owner, repo, cfg.GetBranchLabel())
+		if err != nil {
+			return fmt.Errorf("failed to get closed branch issues: %w", err)
 		}
+		targetBranch = matchBranchTracker(closed, opts.branchName)
 	}
 	if targetBranch == nil {
 		return fmt.Errorf("branch not found: %s", opts.branchName)
@@ -890,8 +2619,8 @@ func runBranchCloseWithDeps(cmd *cobra.Command, opts *branchCloseOptions, cfg *c
 						Number: item.IssueNumber,
 					})
 				}
-				// Count done vs incomplete using State from minimal data
-				if item.IssueState == "CLOSED" || item.IssueState == "closed" {
+				// Count done vs incomplete using State and Status from minimal data
+				if isBranchItemDone(cfg, item.IssueState, item.FieldValues) {
 					doneCount++
 				} else {
 					incompleteCount++
@@ -902,58 +2631,77 @@ func runBranchCloseWithDeps(cmd *cobra.Command, opts *branchCloseOptions, cfg *c
 	}
 
 	// Phase 2: Fetch full details only for matching issues (for display and operations)
-	var releaseIssues []api.Issue
+	// and separate done vs incomplete issues
+	// Issues closed as not planned are reported as dropped rather than done
+	var releaseIssues, doneIssues, droppedIssues, incompleteIssues []api.Issue
+	var archiveItemIDs []string // Done and dropped items not yet archived
 	if len(matchingRefs) > 0 {
 		fullItems, err := client.GetProjectItemsByIssues(project.ID, matchingRefs)
 		if err != nil {
 			return fmt.Errorf("failed to get issue details: %w", err)
 		}
 		for _, item := range fullItems {
-			if item.Issue != nil {
-				releaseIssues = append(releaseIssues, *item.Issue)
+			if item.Issue == nil {
+				continue
+			}
+			releaseIssues = append(releaseIssues, *item.Issue)
+			finished := true
+			if isIssueDropped(*item.Issue) {
+				droppedIssues = append(droppedIssues, *item.Issue)
+			} else if isBranchItemDone(cfg, item.Issue.State, item.FieldValues) {
+				doneIssues = append(doneIssues, *item.Issue)
+			} else {
+				incompleteIssues = append(incompleteIssues, *item.Issue)
+				finished = false
+			}
+			if finished && !item.Archived {
+				archiveItemIDs = append(archiveItemIDs, item.ID)
 			}
-		}
-	}
-
-	// Separate done vs incomplete issues (using full details for operations)
-	var doneIssues, incompleteIssues []api.Issue
-	for _, issue := range releaseIssues {
-		if issue.State == "CLOSED" || issue.State == "closed" {
-			doneIssues = append(doneIssues, issue)
-		} else {
-			incompleteIssues = append(incompleteIssues, issue)
 		}
 	}
 
 	// Show branch summary
-	fmt.Fprintf(cmd.OutOrStdout(), "Closing branch: %s\n", opts.branchName)
+	if opts.summaryOnly {
+		fmt.Fprintf(cmd.OutOrStdout(), "Summarizing branch: %s\n", opts.branchName)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "Closing branch: %s\n", opts.branchName)
+	}
 	fmt.Fprintf(cmd.OutOrStdout(), "  Tracker issue: #%d\n", targetBranch.Number)
-	fmt.Fprintf(cmd.OutOrStdout(), "  Issues in release: %d (%d done, %d incomplete)\n",
-		len(releaseIssues), len(doneIssues), len(incompleteIssues))
+	if len(droppedIssues) > 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "  Issues in release: %d (%d done, %d dropped, %d incomplete)\n",
+			len(releaseIssues), len(doneIssues), len(droppedIssues), len(incompleteIssues))
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "  Issues in release: %d (%d done, %d incomplete)\n",
+			len(releaseIssues), len(doneIssues), len(incompleteIssues))
+	}
 	fmt.Fprintln(cmd.OutOrStdout())
 
+	warnUnmergedClosers(cmd.ErrOrStderr(), client, doneIssues, owner, repo)
+
 	// Separate incomplete issues into parking lot and to-move categories
 	var parkingLotIssues, issuesToMove []api.Issue
 	statusFieldName := "Status"
 	if statusField, ok := cfg.Fields["status"]; ok && statusField.Field != "" {
 		statusFieldName = statusField.Field
 	}
-	parkingLotValue := "Parking Lot"
-	if statusField, ok := cfg.Fields["status"]; ok {
-		if val, exists := statusField.Values["parking_lot"]; exists {
-			parkingLotValue = val
+	parkingLotValue := cfg.StatusParkingLotValue()
+
+	var statusFieldID string
+	if len(incompleteIssues) > 0 {
+		if field, err := client.GetProjectField(project.ID, statusFieldName); err == nil {
+			statusFieldID = field.ID
 		}
 	}
 
 	for _, issue := range incompleteIssues {
 		itemID, err := client.GetProjectItemID(project.ID, issue.ID)
-		if err != nil {
+		if err != nil || statusFieldID == "" {
 			// Can't determine status, include in move list
 			issuesToMove = append(issuesToMove, issue)
 			continue
 		}
 
-		status, _ := client.GetProjectItemFieldValue(project.ID, itemID, statusFieldName)
+		status, _ := client.GetProjectItemFieldValue(project.ID, itemID, statusFieldID)
 		if status == parkingLotValue {
 			parkingLotIssues = append(parkingLotIssues, issue)
 		} else {
@@ -961,6 +2709,46 @@ func runBranchCloseWithDeps(cmd *cobra.Command, opts *branchCloseOptions, cfg *c
 		}
 	}
 
+	if opts.failOnParked && !opts.summaryOnly && len(parkingLotIssues) > 0 {
+		if !opts.force {
+			refs := make([]string, len(parkingLotIssues))
+			for i, issue := range parkingLotIssues {
+				refs[i] = fmt.Sprintf("#%d", issue.Number)
+			}
+			return fmt.Errorf("%d parking-lot issues must be triaged before closing: %s", len(parkingLotIssues), strings.Join(refs, ", "))
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: closing with %d parking-lot issue(s) untriaged (--force)\n", len(parkingLotIssues))
+	}
+
+	webhookURL := opts.postWebhook
+	if webhookURL == "" {
+		webhookURL = cfg.GetCloseWebhook()
+	}
+
+	var summary string
+	if summaryTmpl != nil {
+		data := branchCloseSummary{
+			Branch:           releaseVersion,
+			Tracker:          targetBranch.Number,
+			Issues:           releaseIssues,
+			DoneIssues:       doneIssues,
+			DroppedIssues:    droppedIssues,
+			IncompleteIssues: incompleteIssues,
+			MovedIssues:      issuesToMove,
+		}
+		if opts.tag {
+			data.Tag = releaseVersion
+		}
+		summary, err = renderCloseSummary(summaryTmpl, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.summaryOnly {
+		return writeBranchSummaryOnly(cmd, opts, client, targetBranch, summary)
+	}
+
 	// Dry-run mode: show preview and exit
 	if opts.dryRun {
 		fmt.Fprintln(cmd.OutOrStdout(), "[DRY RUN] Preview of changes:")
@@ -971,17 +2759,57 @@ func runBranchCloseWithDeps(cmd *cobra.Command, opts *branchCloseOptions, cfg *c
 			for _, issue := range issuesToMove {
 				fmt.Fprintf(cmd.OutOrStdout(), "  #%d - %s\n", issue.Number, issue.Title)
 			}
+			for _, change := range branchCloseFieldChanges(cfg) {
+				fmt.Fprintf(cmd.OutOrStdout(), "  Would %s\n", change)
+			}
 		}
 		if len(parkingLotIssues) > 0 {
 			fmt.Fprintf(cmd.OutOrStdout(), "Would skip %d Parking Lot issue(s)\n", len(parkingLotIssues))
 		}
-		if opts.tag {
-			fmt.Fprintf(cmd.OutOrStdout(), "Would create git tag: %s\n", releaseVersion)
+		if opts.tag && signTag {
+			fmt.Fprintf(cmd.OutOrStdout(), "Would tag %s (signed)\n", releaseVersion)
+		} else if opts.tag {
+			fmt.Fprintf(cmd.OutOrStdout(), "Would tag %s\n", releaseVersion)
 		}
 		fmt.Fprintf(cmd.OutOrStdout(), "Would close tracker issue #%d\n", targetBranch.Number)
+		if opts.archiveItems {
+			fmt.Fprintf(cmd.OutOrStdout(), "Would archive %d project item(s)\n", len(archiveItemIDs))
+		}
+		if opts.changelog {
+			fmt.Fprintf(cmd.OutOrStdout(), "Would add %s to %s\n", releaseVersion, changelogFile)
+		}
+		if opts.deleteRemote {
+			fmt.Fprintf(cmd.OutOrStdout(), "Would delete git branch %s and origin/%s\n", opts.branchName, opts.branchName)
+		} else if opts.deleteBranch {
+			fmt.Fprintf(cmd.OutOrStdout(), "Would delete git branch %s\n", opts.branchName)
+		}
+		if summaryTmpl != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Would write close summary to tracker #%d:\n%s\n", targetBranch.Number, summary)
+		}
+		if webhookURL != "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "Would post webhook to %s\n", webhookURL)
+		}
+		for _, notifier := range chatNotifiers(cfg) {
+			fmt.Fprintf(cmd.OutOrStdout(), "Would notify %s\n", notifier.platform)
+		}
+		if opts.checklist && len(cfg.Release.Checklist) > 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "Would verify %d checklist item(s)\n", len(cfg.Release.Checklist))
+		}
 		return nil
 	}
 
+	prompter := opts.prompter
+	if prompter == nil {
+		prompter = newReaderPrompter(cmd.InOrStdin(), cmd.OutOrStdout())
+	}
+
+	// Verify release preconditions before touching any issues
+	if opts.checklist {
+		if err := runBranchChecklist(cmd, opts, prompter, cfg.Release.Checklist); err != nil {
+			return err
+		}
+	}
+
 	// Warn about incomplete issues and confirm
 	if len(incompleteIssues) > 0 {
 		if len(issuesToMove) > 0 {
@@ -992,11 +2820,7 @@ func runBranchCloseWithDeps(cmd *cobra.Command, opts *branchCloseOptions, cfg *c
 		}
 
 		if !opts.yes {
-			fmt.Fprint(cmd.OutOrStdout(), "Proceed? (y/n): ")
-			var response string
-			_, _ = fmt.Scanln(&response)
-			response = strings.ToLower(strings.TrimSpace(response))
-			if response != "y" && response != "yes" {
+			if ok, _ := prompter.Confirm("Proceed?"); !ok {
 				fmt.Fprintln(cmd.OutOrStdout(), "Aborted.")
 				return nil
 			}
@@ -1007,6 +2831,18 @@ func runBranchCloseWithDeps(cmd *cobra.Command, opts *branchCloseOptions, cfg *c
 		if len(issuesToMove) > 0 {
 			fmt.Fprintln(cmd.OutOrStdout(), "Moving incomplete issues to backlog...")
 
+			var movedNumbers []int
+
+			// Look the Branch field up once; clearing takes its ID
+			branchFieldID := ""
+			if branchField, ok := cfg.Fields["branch"]; ok {
+				if field, err := client.GetProjectField(project.ID, branchField.Field); err != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "  Warning: could not find the %s field; Branch values are left set: %v\n", branchField.Field, err)
+				} else {
+					branchFieldID = field.ID
+				}
+			}
+
 			for _, issue := range issuesToMove {
 				// Get project item ID
 				itemID, err := client.GetProjectItemID(project.ID, issue.ID)
@@ -1016,72 +2852,681 @@ func runBranchCloseWithDeps(cmd *cobra.Command, opts *branchCloseOptions, cfg *c
 				}
 
 				// Clear Branch field
-				if branchField, ok := cfg.Fields["branch"]; ok {
-					_ = client.SetProjectItemField(project.ID, itemID, branchField.Field, "")
+				if branchFieldID != "" {
+					_ = client.ClearProjectItemField(project.ID, itemID, branchFieldID)
 				}
 
 				// Set status to backlog
 				if statusField, ok := cfg.Fields["status"]; ok {
-					backlogValue := statusField.Values["backlog"]
-					if backlogValue == "" {
-						backlogValue = "Backlog"
-					}
-					_ = client.SetProjectItemField(project.ID, itemID, statusField.Field, backlogValue)
+					_ = client.SetProjectItemField(project.ID, itemID, statusField.Field, cfg.StatusBacklogValue())
 				}
 
 				fmt.Fprintf(cmd.OutOrStdout(), "  #%d - %s\n", issue.Number, issue.Title)
+				movedNumbers = append(movedNumbers, issue.Number)
 			}
 			fmt.Fprintln(cmd.OutOrStdout())
+
+			// Record the moves on the tracker so 'branch reopen --reassign' can undo them
+			if len(movedNumbers) > 0 {
+				if err := recordBranchCloseMoves(client, targetBranch.ID, movedNumbers); err != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to record moved issues on tracker: %v\n", err)
+				}
+			}
 		}
 	} else if !opts.yes {
 		// Confirm even without incomplete issues
-		fmt.Fprint(cmd.OutOrStdout(), "Proceed? (y/n): ")
-		var response string
-		_, _ = fmt.Scanln(&response)
-		response = strings.ToLower(strings.TrimSpace(response))
-		if response != "y" && response != "yes" {
+		if ok, _ := prompter.Confirm("Proceed?"); !ok {
 			fmt.Fprintln(cmd.OutOrStdout(), "Aborted.")
 			return nil
 		}
 		fmt.Fprintln(cmd.OutOrStdout())
 	}
 
-	// Remove 'assigned' label from all open branch issues
-	for _, issue := range incompleteIssues {
-		if err := client.RemoveLabelFromIssue(owner, repo, issue.ID, "assigned"); err != nil {
-			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to remove 'assigned' label from #%d: %v\n", issue.Number, err)
-		}
+	// Record the rendered close summary on the tracker before it closes
+	summaryWritten := false
+	if summaryTmpl != nil {
+		body, err := client.GetIssueBody(targetBranch.ID)
+		if err == nil {
+			err = client.UpdateIssueBodyIfUnchanged(targetBranch.ID, body, setBranchCloseSummary(body, summary))
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to write close summary to tracker: %v\n", err)
+		} else {
+			summaryWritten = true
+		}
+	}
+
+	// Remove 'assigned' label from all open branch issues
+	for _, issue := range releaseIssues {
+		if strings.EqualFold(issue.State, "CLOSED") {
+			continue
+		}
+		if err := client.RemoveLabelFromIssue(owner, repo, issue.ID, "assigned"); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to remove 'assigned' label from #%d: %v\n", issue.Number, err)
+		}
+	}
+
+	// Create git tag if requested
+	if opts.tag {
+		tagMessage := fmt.Sprintf("Release %s", releaseVersion)
+		if signTag {
+			err = client.GitTagSigned(releaseVersion, tagMessage)
+			if err != nil {
+				return fmt.Errorf("failed to create signed git tag: %w", err)
+			}
+		} else {
+			err = client.GitTag(releaseVersion, tagMessage)
+			if err != nil {
+				return fmt.Errorf("failed to create git tag: %w", err)
+			}
+		}
+	}
+
+	// Close the tracker issue
+	err = client.CloseIssue(targetBranch.ID)
+	if err != nil {
+		return fmt.Errorf("failed to close tracker issue: %w", err)
+	}
+
+	// Archive finished items last, once moved issues have had their fields cleared
+	archived := 0
+	if opts.archiveItems {
+		for _, itemID := range archiveItemIDs {
+			if err := client.ArchiveProjectItem(project.ID, itemID); err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to archive project item %s: %v\n", itemID, err)
+				continue
+			}
+			archived++
+		}
+	}
+
+	// Output confirmation
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ Branch closed: %s\n", releaseVersion)
+	if len(issuesToMove) > 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ %d issue(s) moved to backlog (Branch cleared)\n", len(issuesToMove))
+	}
+	if opts.tag && signTag {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Signed tag created: %s\n", releaseVersion)
+	} else if opts.tag {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Tag created: %s\n", releaseVersion)
+	}
+	if opts.archiveItems {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ %d project item(s) archived\n", archived)
+	}
+	if summaryWritten {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Close summary written to tracker\n")
+	}
+
+	// Record the release in the changelog; the branch is already closed, so failures only warn
+	if opts.changelog {
+		written, err := writeBranchChangelog(client, cfg.Categories, releaseVersion, time.Now(), doneIssues, !opts.noGit)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to update %s: %v\n", changelogFile, err)
+		} else if !written {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s already has a section for %s; skipped\n", changelogFile, releaseVersion)
+		} else {
+			fmt.Fprintf(cmd.OutOrStdout(), "✓ %s updated\n", changelogFile)
+		}
+	}
+
+	// Delete the git branch; the branch is already closed, so failures only warn
+	if opts.deleteBranch {
+		deleteGitBranch(cmd, client, opts.branchName, opts.deleteRemote)
+	}
+
+	// Notify external systems; the branch is already closed, so failures only warn
+	if webhookURL != "" {
+		payload := buildBranchClosePayload(releaseVersion, opts.tag, releaseIssues, doneIssues, droppedIssues, incompleteIssues, issuesToMove)
+		if err := postWebhookJSON(webhookURL, payload); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to post webhook: %v\n", err)
+		} else {
+			fmt.Fprintf(cmd.OutOrStdout(), "✓ Webhook notified\n")
+		}
+	}
+	notifyChannels(cmd, cfg, branchCloseNotification(releaseVersion, opts.tag, releaseIssues, doneIssues, droppedIssues, issuesToMove))
+
+	return nil
+}
+
+// deleteGitBranch deletes the local git branch name and, with remote, the
+// branch on origin. A branch that is already gone is reported and skipped.
+func deleteGitBranch(cmd *cobra.Command, client branchClient, name string, remote bool) {
+	if err := client.GitDeleteBranch(name); api.IsNotFound(err) {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: git branch %s not found; skipped\n", name)
+	} else if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to delete git branch %s: %v\n", name, err)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Git branch deleted: %s\n", name)
+	}
+	if !remote {
+		return
+	}
+	if err := client.GitDeleteRemoteBranch(name); api.IsNotFound(err) {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: origin/%s not found; skipped\n", name)
+	} else if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to delete origin/%s: %v\n", name, err)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Remote branch deleted: origin/%s\n", name)
+	}
+}
+
+// deleteGitTag deletes the local git tag and, with remote, the tag on origin.
+// A tag that is not there is reported and skipped.
+func deleteGitTag(cmd *cobra.Command, client branchClient, tag string, remote bool) {
+	if err := client.GitDeleteTag(tag); api.IsNotFound(err) {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: tag %s not found, skipping\n", tag)
+	} else if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to delete tag %s: %v\n", tag, err)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Git tag deleted: %s\n", tag)
+	}
+	if !remote {
+		return
+	}
+	if err := client.GitDeleteRemoteTag(tag); api.IsNotFound(err) {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: tag %s not found on origin, skipping\n", tag)
+	} else if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to delete tag %s from origin: %v\n", tag, err)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Remote tag deleted: origin %s\n", tag)
+	}
+}
+
+// recordBranchCloseMoves writes the close record listing numbers into the
+// tracker body, replacing any record from an earlier close
+func recordBranchCloseMoves(client branchClient, trackerID string, numbers []int) error {
+	body, err := client.GetIssueBody(trackerID)
+	if err != nil {
+		return err
+	}
+	return client.UpdateIssueBodyIfUnchanged(trackerID, body, setBranchCloseRecord(body, numbers))
+}
+
+// branchCloseSummary is the data a close summary template renders. Issue
+// slices hold api.Issue values (Number, Title, State, URL, Labels, ...).
+type branchCloseSummary struct {
+	Branch           string      // Branch name, e.g. "v1.2.0"
+	Tag              string      // Tag created by the close, empty without --tag
+	Tracker          int         // Tracker issue number
+	Issues           []api.Issue // Every issue in the branch
+	DoneIssues       []api.Issue // Done issues
+	DroppedIssues    []api.Issue // Issues closed as not planned
+	IncompleteIssues []api.Issue // Issues not done, including Parking Lot
+	MovedIssues      []api.Issue // Incomplete issues moved to backlog
+}
+
+// warnUnmergedClosers warns about closed issues whose closing pull requests
+// were not all merged, since the work may not have shipped. It only warns, so
+// a failed lookup is reported and skipped.
+func warnUnmergedClosers(w io.Writer, client branchClient, issues []api.Issue, owner, repo string) {
+	type repoKey struct{ owner, repo string }
+	var repos []repoKey
+	numbers := make(map[repoKey][]int)
+	for _, issue := range issues {
+		if issue.State != "CLOSED" {
+			continue
+		}
+		key := repoKey{owner, repo}
+		if issue.Repository.Owner != "" && issue.Repository.Name != "" {
+			key = repoKey{issue.Repository.Owner, issue.Repository.Name}
+		}
+		if _, seen := numbers[key]; !seen {
+			repos = append(repos, key)
+		}
+		numbers[key] = append(numbers[key], issue.Number)
+	}
+
+	for _, key := range repos {
+		closers, err := client.GetIssueClosers(key.owner, key.repo, numbers[key])
+		if err != nil {
+			fmt.Fprintf(w, "Warning: could not check closing pull requests: %v\n", err)
+			continue
+		}
+		for _, number := range numbers[key] {
+			if closers[number].HasUnmergedClosers {
+				fmt.Fprintf(w, "Warning: issue #%d is closed but its PR isn't merged\n", number)
+			}
+		}
+	}
+}
+
+// branchCloseSummaryStart and branchCloseSummaryEnd delimit the close summary
+// in the tracker body, so a re-close replaces it
+const (
+	branchCloseSummaryStart = "<!-- gh-pmu:close-summary -->"
+	branchCloseSummaryEnd   = "<!-- /gh-pmu:close-summary -->"
+)
+
+// branchCloseSummaryRegex matches a close summary section with its leading blank lines
+var branchCloseSummaryRegex = regexp.MustCompile(`(?s)\n*` + regexp.QuoteMeta(branchCloseSummaryStart) + `.*?` + regexp.QuoteMeta(branchCloseSummaryEnd))
+
+// defaultCloseSummaryTemplate is the summary branch close --summary-only
+// writes when no close summary template is configured
+var defaultCloseSummaryTemplate = template.Must(template.New("close-summary").Parse(`## {{.Branch}} close summary
+
+{{len .Issues}} issues: {{len .DoneIssues}} done, {{len .DroppedIssues}} dropped, {{len .IncompleteIssues}} incomplete
+{{- if .DoneIssues}}
+
+### Done
+{{range .DoneIssues}}
+- #{{.Number}} {{.Title}}
+{{- end}}
+{{- end}}
+{{- if .DroppedIssues}}
+
+### Dropped
+{{range .DroppedIssues}}
+- #{{.Number}} {{.Title}}
+{{- end}}
+{{- end}}
+{{- if .IncompleteIssues}}
+
+### Incomplete
+{{range .IncompleteIssues}}
+- #{{.Number}} {{.Title}}
+{{- end}}
+{{- end}}`))
+
+// loadCloseSummaryTemplate parses a close summary template file. Parse errors
+// name the file and line, e.g. "template: close.tmpl:3: unexpected ...".
+func loadCloseSummaryTemplate(path string) (*template.Template, error) {
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read summary template: %w", err)
+	}
+	tmpl, err := template.New(filepath.Base(path)).Option("missingkey=error").Parse(string(text))
+	if err != nil {
+		return nil, fmt.Errorf("invalid summary template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// renderCloseSummary executes the close summary template against data
+func renderCloseSummary(tmpl *template.Template, data branchCloseSummary) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render summary template: %w", err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// setBranchCloseSummary writes summary into body between the close summary
+// markers, replacing any summary from an earlier close
+func setBranchCloseSummary(body, summary string) string {
+	section := branchCloseSummaryStart + "\n" + summary + "\n" + branchCloseSummaryEnd
+	body = strings.TrimRight(branchCloseSummaryRegex.ReplaceAllString(body, ""), "\n")
+	if body == "" {
+		return section
+	}
+	return body + "\n\n" + section
+}
+
+// changelogFile is the changelog branch close --generate-changelog updates,
+// relative to the working directory
+const changelogFile = "CHANGELOG.md"
+
+// changelogHeader starts a changelog that did not exist yet
+const changelogHeader = "# Changelog\n"
+
+// defaultChangelogCategories group the changelog when no categories are
+// configured; issues matching neither go under Changed
+var defaultChangelogCategories = config.Categories{
+	{Name: "Added", Labels: []string{"enhancement", "feature"}},
+	{Name: "Fixed", Labels: []string{"bug"}},
+}
+
+// writeBranchChangelog prepends the version's section to the changelog and
+// stages it when stage is set. It reports false without writing when the
+// changelog already has a section for the version.
+func writeBranchChangelog(client branchClient, categories config.Categories, version string, date time.Time, issues []api.Issue, stage bool) (bool, error) {
+	existing, err := client.ReadFile(changelogFile)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+	updated, ok := prependChangelogSection(existing, version, formatChangelogSection(categories, version, date, issues))
+	if !ok {
+		return false, nil
+	}
+	if err := client.WriteFile(changelogFile, updated); err != nil {
+		return false, err
+	}
+	if stage {
+		if err := client.GitAdd(changelogFile); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// formatChangelogSection renders the "## <version> - <date>" section with
+// issues grouped by category
+func formatChangelogSection(categories config.Categories, version string, date time.Time, issues []api.Issue) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s - %s\n", version, date.Format("2006-01-02"))
+
+	for _, group := range groupIssuesByCategory(categories, issues) {
+		fmt.Fprintf(&b, "\n### %s\n\n", group.name)
+		for _, issue := range group.issues {
+			fmt.Fprintf(&b, "- %s (#%d)\n", issue.Title, issue.Number)
+		}
+	}
+	if len(issues) == 0 {
+		b.WriteString("\nNo issues completed.\n")
+	}
+	return b.String()
+}
+
+// issueCategoryGroup is one section of release notes
+type issueCategoryGroup struct {
+	name   string
+	issues []api.Issue
+}
+
+// groupIssuesByCategory buckets issues by their labels into the configured
+// categories, unmatched issues last under config.OtherCategory. Without
+// configured categories the changelog defaults apply, with Changed for the
+// rest. Empty groups are dropped; issues sort by number within a group.
+func groupIssuesByCategory(categories config.Categories, issues []api.Issue) []issueCategoryGroup {
+	other := config.OtherCategory
+	if len(categories) == 0 {
+		categories, other = defaultChangelogCategories, "Changed"
+	}
+
+	byName := make(map[string][]api.Issue)
+	for _, issue := range issues {
+		labels := make([]string, len(issue.Labels))
+		for i, label := range issue.Labels {
+			labels[i] = label.Name
+		}
+		name := categories.Categorize(labels)
+		byName[name] = append(byName[name], issue)
+	}
+
+	var groups []issueCategoryGroup
+	for _, name := range append(categoryNames(categories), config.OtherCategory) {
+		group := byName[name]
+		if len(group) == 0 {
+			continue
+		}
+		delete(byName, name) // A category named Other is listed only once
+		sort.Slice(group, func(a, b int) bool { return group[a].Number < group[b].Number })
+		if name == config.OtherCategory {
+			name = other
+		}
+		groups = append(groups, issueCategoryGroup{name: name, issues: group})
+	}
+	return groups
+}
+
+// categoryNames returns the category names in order
+func categoryNames(categories config.Categories) []string {
+	names := make([]string, len(categories))
+	for i, category := range categories {
+		names[i] = category.Name
+	}
+	return names
+}
+
+// changelogVersionRegex matches the heading line of a version's section
+func changelogVersionRegex(version string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)^## \[?` + regexp.QuoteMeta(version) + `\]?(\s|$)`)
+}
+
+// prependChangelogSection inserts section above the first existing version
+// section, below any header, creating the header for an empty changelog. It
+// returns false when the changelog already has a section for version.
+func prependChangelogSection(existing, version, section string) (string, bool) {
+	if changelogVersionRegex(version).MatchString(existing) {
+		return existing, false
+	}
+	if strings.TrimSpace(existing) == "" {
+		return changelogHeader + "\n" + section, true
+	}
+	loc := regexp.MustCompile(`(?m)^## `).FindStringIndex(existing)
+	if loc == nil {
+		return strings.TrimRight(existing, "\n") + "\n\n" + section, true
+	}
+	return existing[:loc[0]] + section + "\n" + existing[loc[0]:], true
+}
+
+// webhookTimeout bounds how long branch close waits on a webhook endpoint
+const webhookTimeout = 10 * time.Second
+
+// branchClosePayload is the JSON body posted to the close webhook
+type branchClosePayload struct {
+	Event      string               `json:"event"`
+	Branch     string               `json:"branch"`
+	Tag        string               `json:"tag,omitempty"`
+	Total      int                  `json:"total"`
+	Done       int                  `json:"done"`
+	Dropped    int                  `json:"dropped"`
+	Incomplete int                  `json:"incomplete"`
+	Moved      int                  `json:"moved"`
+	Issues     []branchPayloadIssue `json:"issues"`
+}
+
+// branchPayloadIssue is a single issue entry in a webhook payload
+type branchPayloadIssue struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+	URL    string `json:"url,omitempty"`
+}
+
+// buildBranchClosePayload assembles the webhook payload for a closed branch
+func buildBranchClosePayload(branch string, tagged bool, issues, done, dropped, incomplete, moved []api.Issue) branchClosePayload {
+	payload := branchClosePayload{
+		Event:      "branch.closed",
+		Branch:     branch,
+		Total:      len(issues),
+		Done:       len(done),
+		Dropped:    len(dropped),
+		Incomplete: len(incomplete),
+		Moved:      len(moved),
+		Issues:     []branchPayloadIssue{},
+	}
+	if tagged {
+		payload.Tag = branch
+	}
+	for _, issue := range issues {
+		payload.Issues = append(payload.Issues, branchPayloadIssue{
+			Number: issue.Number,
+			Title:  issue.Title,
+			State:  issue.State,
+			URL:    issue.URL,
+		})
+	}
+	return payload
+}
+
+// postWebhookJSON POSTs the payload as JSON and fails on non-2xx responses
+func postWebhookJSON(url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	httpClient := &http.Client{Timeout: webhookTimeout}
+	resp, err := httpClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// branchNotification is a chat message about a branch lifecycle event: a
+// headline followed by detail lines
+type branchNotification struct {
+	title string
+	lines []string
+}
+
+// slackPayload formats n for a Slack incoming webhook (mrkdwn bold headline)
+func (n branchNotification) slackPayload() interface{} {
+	return struct {
+		Text string `json:"text"`
+	}{Text: strings.Join(append([]string{"*" + n.title + "*"}, n.lines...), "\n")}
+}
+
+// discordPayload formats n for a Discord webhook (markdown bold headline)
+func (n branchNotification) discordPayload() interface{} {
+	return struct {
+		Content string `json:"content"`
+	}{Content: strings.Join(append([]string{"**" + n.title + "**"}, n.lines...), "\n")}
+}
+
+// chatNotifier is a configured chat platform and its payload format
+type chatNotifier struct {
+	platform string
+	url      string
+	payload  func(branchNotification) interface{}
+}
+
+// chatNotifiers returns the chat platforms with a webhook configured under
+// notifications
+func chatNotifiers(cfg *config.Config) []chatNotifier {
+	all := []chatNotifier{
+		{platform: "Slack", url: cfg.GetSlackWebhook(), payload: branchNotification.slackPayload},
+		{platform: "Discord", url: cfg.GetDiscordWebhook(), payload: branchNotification.discordPayload},
+	}
+	var configured []chatNotifier
+	for _, notifier := range all {
+		if notifier.url != "" {
+			configured = append(configured, notifier)
+		}
+	}
+	return configured
+}
+
+// notifyChannels posts n to every configured chat platform. A failed post
+// only warns.
+func notifyChannels(cmd *cobra.Command, cfg *config.Config, n branchNotification) {
+	for _, notifier := range chatNotifiers(cfg) {
+		if err := postWebhookJSON(notifier.url, notifier.payload(n)); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to notify %s: %v\n", notifier.platform, err)
+		} else {
+			fmt.Fprintf(cmd.OutOrStdout(), "✓ %s notified\n", notifier.platform)
+		}
+	}
+}
+
+// branchCloseNotification summarizes a closed branch for chat channels
+func branchCloseNotification(branch string, tagged bool, issues, done, dropped, moved []api.Issue) branchNotification {
+	n := branchNotification{
+		title: fmt.Sprintf("Branch %s closed", branch),
+		lines: []string{fmt.Sprintf("%d of %d issue(s) done", len(done), len(issues))},
+	}
+	if tagged {
+		n.title = fmt.Sprintf("Branch %s closed and tagged", branch)
+	}
+	if len(dropped) > 0 {
+		n.lines = append(n.lines, fmt.Sprintf("%d dropped", len(dropped)))
+	}
+	if len(moved) > 0 {
+		n.lines = append(n.lines, fmt.Sprintf("%d moved to backlog", len(moved)))
+	}
+	return n
+}
+
+// newBranchCodenameCommand creates the branch codename subcommand
+func newBranchCodenameCommand() *cobra.Command {
+	opts := &branchCodenameOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "codename <branch-name> <codename>",
+		Short: "Set or change the codename of an active branch",
+		Long: `Sets the codename shown in parentheses in an active branch's tracker
+title, e.g. "Branch: release/v2.0.0 (Phoenix)". An existing codename is
+replaced; an empty codename removes it. The branch name is unchanged.
+
+Examples:
+  gh pmu branch codename release/v2.0.0 Phoenix
+  gh pmu branch codename release/v2.0.0 ""   # Remove the codename`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.branchName = args[0]
+			opts.codename = args[1]
+
+			cwd, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get current directory: %w", err)
+			}
+			cfg, err := loadConfig(cwd)
+			if err != nil {
+				return fmt.Errorf("failed to load configuration: %w", err)
+			}
+
+			client := newAPIClient()
+			return runBranchCodenameWithDeps(cmd, opts, cfg, client)
+		},
 	}
 
-	// Create git tag if requested
-	if opts.tag {
-		tagMessage := fmt.Sprintf("Release %s", releaseVersion)
-		err = client.GitTag(releaseVersion, tagMessage)
-		if err != nil {
-			return fmt.Errorf("failed to create git tag: %w", err)
-		}
+	return cmd
+}
+
+// runBranchCodenameWithDeps rewrites the codename of an active branch's
+// tracker title. It receives all dependencies as parameters for easy mocking.
+func runBranchCodenameWithDeps(cmd *cobra.Command, opts *branchCodenameOptions, cfg *config.Config, client branchClient) error {
+	owner, repo, err := parseOwnerRepo(cfg)
+	if err != nil {
+		return err
 	}
 
-	// Close the tracker issue
-	err = client.CloseIssue(targetBranch.ID)
+	issues, err := client.GetOpenIssuesByLabel(owner, repo, cfg.GetBranchLabel())
 	if err != nil {
-		return fmt.Errorf("failed to close tracker issue: %w", err)
+		return fmt.Errorf("failed to get branch issues: %w", err)
+	}
+	var tracker *api.Issue
+	for i := range issues {
+		if isBranchTracker(issues[i].Title) && extractBranchVersion(issues[i].Title) == opts.branchName {
+			tracker = &issues[i]
+			break
+		}
+	}
+	if tracker == nil {
+		return fmt.Errorf("active branch not found: %s", opts.branchName)
 	}
 
-	// Output confirmation
-	fmt.Fprintf(cmd.OutOrStdout(), "✓ Branch closed: %s\n", releaseVersion)
-	if len(issuesToMove) > 0 {
-		fmt.Fprintf(cmd.OutOrStdout(), "✓ %d issue(s) moved to backlog (Branch cleared)\n", len(issuesToMove))
+	codename := strings.TrimSpace(opts.codename)
+	title := setBranchCodename(tracker.Title, codename)
+	if title == tracker.Title {
+		fmt.Fprintf(cmd.OutOrStdout(), "Tracker #%d already titled %q\n", tracker.Number, title)
+		return nil
 	}
-	if opts.tag {
-		fmt.Fprintf(cmd.OutOrStdout(), "✓ Tag created: %s\n", releaseVersion)
+	if err := client.UpdateIssueTitle(tracker.ID, title); err != nil {
+		return fmt.Errorf("failed to update tracker title: %w", err)
 	}
 
+	if codename == "" {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Removed codename from %s (tracker #%d)\n", opts.branchName, tracker.Number)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Set codename of %s to %s (tracker #%d)\n", opts.branchName, codename, tracker.Number)
+	}
 	return nil
 }
 
+// setBranchCodename returns a tracker title with its "(codename)" suffix
+// replaced by codename, or removed when codename is empty
+// e.g., ("Branch: v1.2.0 (Phoenix)", "Atlas") -> "Branch: v1.2.0 (Atlas)"
+func setBranchCodename(title, codename string) string {
+	if idx := strings.Index(title, " ("); idx > 0 {
+		title = title[:idx]
+	}
+	if codename == "" {
+		return title
+	}
+	return fmt.Sprintf("%s (%s)", title, codename)
+}
+
 // newBranchReopenCommand creates the release reopen subcommand
 func newBranchReopenCommand() *cobra.Command {
+	opts := &branchReopenOptions{}
+
 	cmd := &cobra.Command{
 		Use:   "reopen <branch-name>",
 		Short: "Reopen a closed branch",
@@ -1090,19 +3535,29 @@ func newBranchReopenCommand() *cobra.Command {
 Use this to continue work on a branch after it has been closed.
 The branch name must be specified explicitly.
 
+With --reassign, the issues that 'branch close' moved to backlog get
+their Branch field set back to this branch. Issues that have since been
+assigned to another branch are skipped.
+
+With --tag-delete, the git tag for the branch version is deleted after the
+tracker reopens, so a premature release tag does not linger; --delete-remote
+deletes it from origin as well. A tag that is not there is skipped.
+
 Examples:
   gh pmu branch reopen release/v2.0.0
-  gh pmu branch reopen patch/v1.9.1`,
+  gh pmu branch reopen patch/v1.9.1
+  gh pmu branch reopen release/v2.0.0 --reassign
+  gh pmu branch reopen v1.2.0 --tag-delete --delete-remote`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			branchName := args[0]
+			opts.branchName = args[0]
 
 			cwd, err := os.Getwd()
 			if err != nil {
 				return fmt.Errorf("failed to get current directory: %w", err)
 			}
 
-			cfg, err := config.LoadFromDirectory(cwd)
+			cfg, err := loadConfig(cwd)
 			if err != nil {
 				return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 			}
@@ -1111,43 +3566,54 @@ Examples:
 				return fmt.Errorf("invalid configuration: %w", err)
 			}
 
-			client := api.NewClient()
-			return runBranchReopenWithDeps(cmd, branchName, cfg, client)
+			client := newAPIClient()
+			return runBranchReopenWithDeps(cmd, opts, cfg, client)
 		},
 	}
 
+	cmd.Flags().BoolVar(&opts.reassign, "reassign", false, "Restore the Branch field on issues moved to backlog at close")
+	cmd.Flags().BoolVar(&opts.tagDelete, "tag-delete", false, "Delete the git tag for the branch version after reopening")
+	cmd.Flags().BoolVar(&opts.deleteRemote, "delete-remote", false, "With --tag-delete, also delete the tag from origin")
+
 	return cmd
 }
 
-func runBranchReopenWithDeps(cmd *cobra.Command, branchName string, cfg *config.Config, client branchClient) error {
+func runBranchReopenWithDeps(cmd *cobra.Command, opts *branchReopenOptions, cfg *config.Config, client branchClient) error {
+	branchName := opts.branchName
+	if opts.deleteRemote && !opts.tagDelete {
+		return fmt.Errorf("--delete-remote requires --tag-delete")
+	}
+
 	owner, repo, err := parseOwnerRepo(cfg)
 	if err != nil {
 		return err
 	}
 
 	// Get closed branch issues
-	issues, err := client.GetClosedIssuesByLabel(owner, repo, "branch")
+	issues, err := client.GetClosedIssuesByLabel(owner, repo, cfg.GetBranchLabel())
 	if err != nil {
 		return fmt.Errorf("failed to get closed branch issues: %w", err)
 	}
 
-	// Find the specified branch by name (supports both "Branch: " and "Release: " formats)
-	var targetBranch *api.Issue
-	expectedTitleNew := fmt.Sprintf("Branch: %s", branchName)
-	expectedTitleLegacy := fmt.Sprintf("Release: %s", branchName)
-	for i := range issues {
-		title := issues[i].Title
-		if title == expectedTitleNew || strings.HasPrefix(title, expectedTitleNew+" (") ||
-			title == expectedTitleLegacy || strings.HasPrefix(title, expectedTitleLegacy+" (") {
-			targetBranch = &issues[i]
-			break
-		}
-	}
-
+	targetBranch := matchBranchTracker(issues, branchName)
 	if targetBranch == nil {
 		return fmt.Errorf("closed branch not found: %s", branchName)
 	}
 
+	// Read the close record before reopening so a missing record changes nothing
+	var movedNumbers []int
+	if opts.reassign {
+		body, err := client.GetIssueBody(targetBranch.ID)
+		if err != nil {
+			return fmt.Errorf("failed to read tracker body: %w", err)
+		}
+		numbers, ok := parseBranchCloseRecord(body)
+		if !ok {
+			return fmt.Errorf("no close record found for branch %s", branchName)
+		}
+		movedNumbers = numbers
+	}
+
 	// Reopen the tracker issue
 	err = client.ReopenIssue(targetBranch.ID)
 	if err != nil {
@@ -1157,9 +3623,113 @@ func runBranchReopenWithDeps(cmd *cobra.Command, branchName string, cfg *config.
 	branchVersion := extractBranchVersion(targetBranch.Title)
 	fmt.Fprintf(cmd.OutOrStdout(), "Reopened branch %s (tracker #%d)\n", branchVersion, targetBranch.Number)
 
+	// The tracker is open again, so a failed tag delete only warns
+	if opts.tagDelete {
+		deleteGitTag(cmd, client, branchVersion, opts.deleteRemote)
+	}
+
+	if opts.reassign {
+		return reassignBranchIssues(cmd, cfg, client, owner, repo, branchVersion, movedNumbers)
+	}
+
+	return nil
+}
+
+// reassignBranchIssues sets the Branch field back to version on the issues
+// recorded at close. Issues that already have a branch are left alone.
+func reassignBranchIssues(cmd *cobra.Command, cfg *config.Config, client branchClient, owner, repo, version string, numbers []int) error {
+	branchField, ok := cfg.Fields["branch"]
+	if !ok {
+		return fmt.Errorf("branch field not configured")
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	field, err := client.GetProjectField(project.ID, branchField.Field)
+	if err != nil {
+		return fmt.Errorf("failed to get branch field: %w", err)
+	}
+
+	reassigned, skipped := 0, 0
+	for _, number := range numbers {
+		issue, err := client.GetIssueByNumber(owner, repo, number)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "  Warning: could not get issue #%d: %v\n", number, err)
+			continue
+		}
+
+		itemID, err := client.GetProjectItemID(project.ID, issue.ID)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "  Warning: could not find project item for #%d: %v\n", number, err)
+			continue
+		}
+
+		current, err := client.GetProjectItemFieldValue(project.ID, itemID, field.ID)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "  Warning: could not read branch field for #%d: %v\n", number, err)
+			continue
+		}
+		if current != "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "  #%d - already assigned to %s, skipped\n", number, current)
+			skipped++
+			continue
+		}
+
+		if err := client.SetProjectItemField(project.ID, itemID, branchField.Field, version); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "  Warning: failed to set branch field for #%d: %v\n", number, err)
+			continue
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "  #%d - %s\n", number, issue.Title)
+		reassigned++
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "✓ %d issue(s) reassigned to %s\n", reassigned, version)
+	if skipped > 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "ℹ️  Skipped %d already-assigned issue(s)\n", skipped)
+	}
+
 	return nil
 }
 
+// branchCloseRecordRegex matches the hidden marker branch close leaves in the
+// tracker body, listing the issue numbers it moved to backlog
+var branchCloseRecordRegex = regexp.MustCompile(`\n*<!-- gh-pmu:moved-at-close ([0-9,]*) -->`)
+
+// setBranchCloseRecord returns body with its close record replaced by one
+// listing numbers
+func setBranchCloseRecord(body string, numbers []int) string {
+	parts := make([]string, len(numbers))
+	for i, n := range numbers {
+		parts[i] = strconv.Itoa(n)
+	}
+	marker := fmt.Sprintf("<!-- gh-pmu:moved-at-close %s -->", strings.Join(parts, ","))
+
+	body = strings.TrimRight(branchCloseRecordRegex.ReplaceAllString(body, ""), "\n")
+	if body == "" {
+		return marker
+	}
+	return body + "\n\n" + marker
+}
+
+// parseBranchCloseRecord returns the issue numbers in body's close record.
+// The bool is false when the body has no record.
+func parseBranchCloseRecord(body string) ([]int, bool) {
+	m := branchCloseRecordRegex.FindStringSubmatch(body)
+	if m == nil {
+		return nil, false
+	}
+	var numbers []int
+	for _, part := range strings.Split(m[1], ",") {
+		if n, err := strconv.Atoi(part); err == nil {
+			numbers = append(numbers, n)
+		}
+	}
+	return numbers, true
+}
+
 // extractBranchCodename extracts the codename from a release title
 // e.g., "Release: v1.2.0 (Phoenix)" -> "Phoenix", "Release: v1.2.0" -> ""
 func extractBranchCodename(title string) string {
@@ -1176,54 +3746,416 @@ func extractBranchCodename(title string) string {
 func runBranchListWithDeps(cmd *cobra.Command, opts *branchListOptions, cfg *config.Config, client branchClient) error {
 	var branches []branchInfo
 
+	if opts.schema {
+		if !opts.json {
+			return fmt.Errorf("--schema requires --json")
+		}
+		return writeJSONSchema(cmd.OutOrStdout(), "gh pmu branch list --json", jsonSchemaOf(reflect.TypeOf([]branchListJSON{})))
+	}
+
+	if opts.json && opts.mdTable {
+		return fmt.Errorf("--json and --md-table cannot be combined")
+	}
+
+	// Parse the filter first so a bad expression fails before any API calls
+	filter, err := parseBranchFilter(opts.filter)
+	if err != nil {
+		return err
+	}
+
 	// Fetch from API
 	owner, repo, err := parseOwnerRepo(cfg)
 	if err != nil {
 		return err
 	}
 
-	openIssues, err := client.GetOpenIssuesByLabel(owner, repo, "branch")
+	// Resolve the reference tag first so an unknown tag fails before any API calls
+	var since time.Time
+	if opts.sinceTag != "" {
+		since, err = client.GitTagDate(opts.sinceTag)
+		if err != nil {
+			return fmt.Errorf("failed to read tag %s: %w", opts.sinceTag, err)
+		}
+	}
+
+	openIssues, err := client.GetOpenIssuesByLabel(owner, repo, cfg.GetBranchLabel())
 	if err != nil {
 		return fmt.Errorf("failed to get open branches: %w", err)
 	}
 
-	closedIssues, err := client.GetClosedIssuesByLabel(owner, repo, "branch")
-	if err != nil {
-		return fmt.Errorf("failed to get closed branches: %w", err)
+	// Closed history is a second API call, so only fetch it on request
+	var closedIssues []api.Issue
+	if opts.includeClosed {
+		closedIssues, err = client.GetClosedIssuesByLabel(owner, repo, cfg.GetBranchLabel())
+		if err != nil {
+			return fmt.Errorf("failed to get closed branches: %w", err)
+		}
 	}
 
 	// Combine and filter for branch trackers (supports both "Branch: " and "Release: " formats)
+	// Trackers without a creation date can't be placed after the tag
+	createdSince := func(issue api.Issue) bool {
+		return opts.sinceTag == "" || (!issue.CreatedAt.IsZero() && issue.CreatedAt.After(since))
+	}
 	for _, issue := range openIssues {
-		if isBranchTracker(issue.Title) {
+		if isBranchTracker(issue.Title) && createdSince(issue) {
 			branches = append(branches, extractBranchInfo(issue, "Active"))
 		}
 	}
 	for _, issue := range closedIssues {
-		if isBranchTracker(issue.Title) {
+		if isBranchTracker(issue.Title) && createdSince(issue) {
 			branches = append(branches, extractBranchInfo(issue, "Closed"))
 		}
 	}
 
-	if len(branches) == 0 {
-		fmt.Fprintf(cmd.OutOrStdout(), "No branches found\n")
+	// Sort by version descending
+	sortBranchesByVersionDesc(branches)
+
+	// Tags only decorate the list, so a failure to read them just warns
+	if len(branches) > 0 {
+		tags, err := client.GitListTags()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: could not list git tags: %v\n", err)
+		}
+		tagged := make(map[string]bool, len(tags))
+		for _, tag := range tags {
+			tagged[tag] = true
+		}
+		for i := range branches {
+			branches[i].tagged = tagged[branches[i].version]
+		}
+	}
+
+	if len(filter) > 0 {
+		// Issue counts need the project items, so they are read only when filtered on
+		if filter.uses("issues") && len(branches) > 0 {
+			counts, err := countIssuesByBranch(cfg, client, owner, repo)
+			if err != nil {
+				return err
+			}
+			for i := range branches {
+				branches[i].issues = counts[branches[i].version]
+			}
+		}
+		var matched []branchInfo
+		for _, b := range branches {
+			if filter.matches(b) {
+				matched = append(matched, b)
+			}
+		}
+		branches = matched
+	}
+
+	if opts.json {
+		return writeBranchListJSON(cmd.OutOrStdout(), branches)
+	}
+
+	rows := make([][]string, len(branches))
+	for i, b := range branches {
+		rows[i] = b.row()
+	}
+
+	// Markdown output always has a header, even with no branches
+	if opts.mdTable {
+		writeMarkdownTable(cmd.OutOrStdout(), branchListHeaders, rows)
 		return nil
 	}
 
-	// Sort by version descending
-	sortBranchesByVersionDesc(branches)
+	if len(branches) == 0 {
+		if opts.sinceTag != "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "No branches created since %s\n", opts.sinceTag)
+		} else if opts.includeClosed || opts.filter != "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "No branches found\n")
+		} else {
+			fmt.Fprintf(cmd.OutOrStdout(), "No active branches (use --all to include closed)\n")
+		}
+		return nil
+	}
 
 	// Display table
-	fmt.Fprintf(cmd.OutOrStdout(), "%-12s %-15s %-10s %-10s\n", "VERSION", "CODENAME", "TRACKER", "STATUS")
-	fmt.Fprintf(cmd.OutOrStdout(), "%-12s %-15s %-10s %-10s\n", "-------", "--------", "-------", "------")
+	const rowFormat = "%-12s %-15s %-10s %-10s %-6s\n"
+	fmt.Fprintf(cmd.OutOrStdout(), rowFormat, branchListHeaders[0], branchListHeaders[1], branchListHeaders[2], branchListHeaders[3], branchListHeaders[4])
+	fmt.Fprintf(cmd.OutOrStdout(), rowFormat, "-------", "--------", "-------", "------", "------")
+	for _, row := range rows {
+		fmt.Fprintf(cmd.OutOrStdout(), rowFormat, row[0], row[1], row[2], row[3], row[4])
+	}
+
+	return nil
+}
+
+// branchFilterFields are the fields a branch list --filter condition can test
+var branchFilterFields = []string{"codename", "issues", "status", "tagged", "tracker", "version"}
+
+// branchFilterOps are the --filter operators, two-character ones first so
+// "<=" is not read as "<"
+var branchFilterOps = []string{"<=", ">=", "!=", "=", "<", ">"}
+
+// branchFilterTerm is one field-op-value condition of a --filter expression
+type branchFilterTerm struct {
+	field string
+	op    string
+	value string
+}
+
+// branchFilter is a parsed --filter expression; a branch must match every term
+type branchFilter []branchFilterTerm
+
+// parseBranchFilter parses space-separated <field><op><value> conditions.
+// Errors give the 1-based position of the offending part of expr.
+func parseBranchFilter(expr string) (branchFilter, error) {
+	var filter branchFilter
+	for start := 0; start < len(expr); {
+		if expr[start] == ' ' || expr[start] == '\t' {
+			start++
+			continue
+		}
+		end := start
+		for end < len(expr) && expr[end] != ' ' && expr[end] != '\t' {
+			end++
+		}
+		term, err := parseBranchFilterTerm(expr[start:end], start+1)
+		if err != nil {
+			return nil, err
+		}
+		filter = append(filter, term)
+		start = end
+	}
+	return filter, nil
+}
+
+// parseBranchFilterTerm parses a single condition found at position pos
+func parseBranchFilterTerm(text string, pos int) (branchFilterTerm, error) {
+	nameEnd := 0
+	for nameEnd < len(text) && (text[nameEnd] >= 'a' && text[nameEnd] <= 'z' || text[nameEnd] >= 'A' && text[nameEnd] <= 'Z') {
+		nameEnd++
+	}
+	if nameEnd == 0 {
+		return branchFilterTerm{}, fmt.Errorf("invalid --filter at position %d: expected a field name in %q", pos, text)
+	}
+	field := strings.ToLower(text[:nameEnd])
+	known := false
+	for _, f := range branchFilterFields {
+		if f == field {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return branchFilterTerm{}, fmt.Errorf("invalid --filter at position %d: unknown field %q (valid fields: %s)", pos, text[:nameEnd], strings.Join(branchFilterFields, ", "))
+	}
+
+	op := ""
+	for _, candidate := range branchFilterOps {
+		if strings.HasPrefix(text[nameEnd:], candidate) {
+			op = candidate
+			break
+		}
+	}
+	if op == "" {
+		return branchFilterTerm{}, fmt.Errorf("invalid --filter at position %d: expected one of %s after %q", pos+nameEnd, strings.Join(branchFilterOps, " "), text[:nameEnd])
+	}
+	valuePos := pos + nameEnd + len(op)
+	value := text[nameEnd+len(op):]
+	if value == "" {
+		return branchFilterTerm{}, fmt.Errorf("invalid --filter at position %d: missing value for %s", valuePos, field)
+	}
+
+	term := branchFilterTerm{field: field, op: op, value: value}
+	switch field {
+	case "status", "codename", "tagged":
+		if op != "=" && op != "!=" {
+			return branchFilterTerm{}, fmt.Errorf("invalid --filter at position %d: %s supports only = and !=", pos+nameEnd, field)
+		}
+	}
+	switch field {
+	case "status":
+		switch strings.ToLower(value) {
+		case "open", "active":
+			term.value = "Active"
+		case "closed":
+			term.value = "Closed"
+		default:
+			return branchFilterTerm{}, fmt.Errorf("invalid --filter at position %d: status must be open or closed, got %q", valuePos, value)
+		}
+	case "tagged":
+		switch strings.ToLower(value) {
+		case "true", "yes":
+			term.value = "true"
+		case "false", "no":
+			term.value = "false"
+		default:
+			return branchFilterTerm{}, fmt.Errorf("invalid --filter at position %d: tagged must be true or false, got %q", valuePos, value)
+		}
+	case "tracker", "issues":
+		if _, err := strconv.Atoi(strings.TrimPrefix(value, "#")); err != nil {
+			return branchFilterTerm{}, fmt.Errorf("invalid --filter at position %d: %s must be a number, got %q", valuePos, field, value)
+		}
+		term.value = strings.TrimPrefix(value, "#")
+	}
+	return term, nil
+}
+
+// uses reports whether any term tests field
+func (f branchFilter) uses(field string) bool {
+	for _, term := range f {
+		if term.field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether b satisfies every term
+func (f branchFilter) matches(b branchInfo) bool {
+	for _, term := range f {
+		if !term.matches(b) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches reports whether b satisfies the term. Values were checked by
+// parseBranchFilterTerm.
+func (t branchFilterTerm) matches(b branchInfo) bool {
+	var cmp int
+	switch t.field {
+	case "version":
+		cmp = compareVersions(b.version, t.value)
+	case "status":
+		cmp = strings.Compare(b.status, t.value)
+	case "codename":
+		cmp = strings.Compare(strings.ToLower(b.codename), strings.ToLower(t.value))
+	case "tagged":
+		cmp = strings.Compare(strconv.FormatBool(b.tagged), t.value)
+	case "tracker", "issues":
+		n, _ := strconv.Atoi(t.value)
+		actual := b.trackerNum
+		if t.field == "issues" {
+			actual = b.issues
+		}
+		cmp = actual - n
+	}
+	switch t.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	}
+	return cmp >= 0
+}
+
+// countIssuesByBranch counts the repository's project items per Branch (or
+// legacy Release) field value
+func countIssuesByBranch(cfg *config.Config, client branchClient, owner, repo string) (map[string]int, error) {
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	filter := &api.ProjectItemsFilter{Repository: fmt.Sprintf("%s/%s", owner, repo)}
+	items, err := client.GetProjectItemsMinimal(project.ID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project items: %w", err)
+	}
+	counts := make(map[string]int)
+	for _, item := range items {
+		for _, fv := range item.FieldValues {
+			if (fv.Field == BranchFieldName || fv.Field == LegacyReleaseFieldName) && fv.Value != "" {
+				counts[fv.Value]++
+				break
+			}
+		}
+	}
+	return counts, nil
+}
+
+// branchListHeaders are the branch list columns, shared by every output format
+var branchListHeaders = []string{"VERSION", "CODENAME", "TRACKER", "STATUS", "TAGGED"}
+
+// row returns the branch's cells in branchListHeaders order
+func (b branchInfo) row() []string {
+	codename := b.codename
+	if codename == "" {
+		codename = "-"
+	}
+	tagged := "no"
+	if b.tagged {
+		tagged = "yes"
+	}
+	return []string{b.version, codename, fmt.Sprintf("#%d", b.trackerNum), b.status, tagged}
+}
+
+// branchListJSON is a branch list entry in --json output
+type branchListJSON struct {
+	Version  string `json:"version"`
+	Codename string `json:"codename,omitempty"`
+	Tracker  int    `json:"tracker"`
+	Status   string `json:"status"`
+	Tagged   bool   `json:"tagged"`
+}
+
+// writeBranchListJSON writes branches as an indented JSON array, empty
+// rather than null when there are none
+func writeBranchListJSON(w io.Writer, branches []branchInfo) error {
+	output := make([]branchListJSON, 0, len(branches))
 	for _, b := range branches {
-		codenameDisplay := b.codename
-		if codenameDisplay == "" {
-			codenameDisplay = "-"
+		output = append(output, branchListJSON{
+			Version:  b.version,
+			Codename: b.codename,
+			Tracker:  b.trackerNum,
+			Status:   b.status,
+			Tagged:   b.tagged,
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(output)
+}
+
+// branchIssueTable returns the columns and rows for listing branch issues
+func branchIssueTable(issues []api.Issue, withURL bool) ([]string, [][]string) {
+	headers := []string{"ISSUE", "TITLE", "STATE"}
+	if withURL {
+		headers = append(headers, "URL")
+	}
+	rows := make([][]string, 0, len(issues))
+	for _, issue := range issues {
+		row := []string{fmt.Sprintf("#%d", issue.Number), issue.Title, issue.State}
+		if withURL {
+			row = append(row, issue.URL)
 		}
-		fmt.Fprintf(cmd.OutOrStdout(), "%-12s %-15s #%-9d %-10s\n", b.version, codenameDisplay, b.trackerNum, b.status)
+		rows = append(rows, row)
 	}
+	return headers, rows
+}
 
-	return nil
+// writeMarkdownTable writes a GitHub-flavored markdown table. Pipes in cells
+// are escaped and newlines flattened so each row stays on one line.
+func writeMarkdownTable(w io.Writer, headers []string, rows [][]string) {
+	escape := strings.NewReplacer("|", "\\|", "\r\n", " ", "\n", " ")
+	writeRow := func(cells []string) {
+		escaped := make([]string, len(cells))
+		for i, c := range cells {
+			escaped[i] = escape.Replace(c)
+		}
+		fmt.Fprintf(w, "| %s |\n", strings.Join(escaped, " | "))
+	}
+
+	writeRow(headers)
+	separator := make([]string, len(headers))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	fmt.Fprintf(w, "| %s |\n", strings.Join(separator, " | "))
+	for _, row := range rows {
+		writeRow(row)
+	}
 }
 
 // branchInfo holds parsed release information
@@ -1232,6 +4164,8 @@ type branchInfo struct {
 	codename   string
 	trackerNum int
 	status     string
+	tagged     bool // A git tag named after the version exists
+	issues     int  // Issues assigned to the branch, counted only for --filter
 }
 
 // extractBranchInfo extracts release information from an issue
@@ -1298,9 +4232,10 @@ func parseBranchTitle(title string) (version, track string) {
 	return version, track
 }
 
-// SyncActiveBranches queries open branch issues and returns active branch entries
-func SyncActiveBranches(client branchClient, owner, repo string) ([]branchActiveEntry, error) {
-	issues, err := client.GetOpenIssuesByLabel(owner, repo, "branch")
+// SyncActiveBranches queries open issues carrying the branch tracker label and
+// returns active branch entries
+func SyncActiveBranches(client branchClient, owner, repo, label string) ([]branchActiveEntry, error) {
+	issues, err := client.GetOpenIssuesByLabel(owner, repo, label)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get branch issues: %w", err)
 	}
@@ -143,7 +143,7 @@ func updateStatusToDone(issueNum int, repoOverride string) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
@@ -153,7 +153,7 @@ func updateStatusToDone(issueNum int, repoOverride string) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return updateStatusToDoneWithDeps(issueNum, repoOverride, cfg, client, os.Stdout)
 }
@@ -169,9 +169,9 @@ func updateStatusToDoneWithDeps(issueNum int, repoOverride string, cfg *config.C
 		}
 		owner, repo = parts[0], parts[1]
 	} else if len(cfg.Repositories) > 0 {
-		parts := strings.Split(cfg.Repositories[0], "/")
+		parts := strings.Split(cfg.DefaultRepository(), "/")
 		if len(parts) != 2 {
-			return fmt.Errorf("invalid repository format in config: %s", cfg.Repositories[0])
+			return fmt.Errorf("invalid repository format in config: %s", cfg.DefaultRepository())
 		}
 		owner, repo = parts[0], parts[1]
 	} else {
@@ -190,8 +190,8 @@ func updateStatusToDoneWithDeps(issueNum int, repoOverride string, cfg *config.C
 		return fmt.Errorf("failed to find issue in project: %w", err)
 	}
 
-	// Resolve "done" status value
-	doneValue := cfg.ResolveFieldValue("status", "done")
+	// Resolve the configured done status value
+	doneValue := cfg.StatusDoneValue()
 
 	// Update the status
 	if err := client.SetProjectItemField(project.ID, itemID, "Status", doneValue); err != nil {
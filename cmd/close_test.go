@@ -16,6 +16,9 @@ type mockCloseClient struct {
 	project *api.Project
 	itemID  string
 
+	// lastSetValue records the value of the latest SetProjectItemField call
+	lastSetValue string
+
 	// Error injection
 	getProjectErr          error
 	getProjectItemIDErr    error
@@ -47,6 +50,7 @@ func (m *mockCloseClient) GetProjectItemIDForIssue(projectID, owner, repo string
 }
 
 func (m *mockCloseClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	m.lastSetValue = value
 	return m.setProjectItemFieldErr
 }
 
@@ -90,6 +94,33 @@ func TestUpdateStatusToDoneWithDeps_Success(t *testing.T) {
 	}
 }
 
+func TestUpdateStatusToDoneWithDeps_UsesConfiguredDoneValue(t *testing.T) {
+	mock := newMockCloseClient()
+	cfg := &config.Config{
+		Project:      config.Project{Owner: "test-org", Number: 1},
+		Repositories: []string{"test-org/test-repo"},
+		Fields: map[string]config.Field{
+			"status": {
+				Field:     "Status",
+				Values:    map[string]string{"done": "Done", "shipped": "Shipped"},
+				Semantics: &config.StatusSemantics{DoneValues: []string{"shipped", "Done"}},
+			},
+		},
+	}
+
+	stdout, _ := os.CreateTemp("", "stdout")
+	defer os.Remove(stdout.Name())
+
+	err := updateStatusToDoneWithDeps(42, "", cfg, mock, stdout)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.lastSetValue != "Shipped" {
+		t.Errorf("expected the first done value Shipped, got %q", mock.lastSetValue)
+	}
+}
+
 func TestUpdateStatusToDoneWithDeps_WithRepoOverride(t *testing.T) {
 	mock := newMockCloseClient()
 	cfg := &config.Config{
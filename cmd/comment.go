@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/rubrical-studios/gh-pmu/internal/api"
-	"github.com/rubrical-studios/gh-pmu/internal/config"
 	"github.com/spf13/cobra"
 )
 
@@ -82,7 +81,7 @@ func runComment(cmd *cobra.Command, opts *commentOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -103,15 +102,15 @@ func runComment(cmd *cobra.Command, opts *commentOptions) error {
 		if len(cfg.Repositories) == 0 {
 			return fmt.Errorf("no repository configured")
 		}
-		repoParts := strings.Split(cfg.Repositories[0], "/")
+		repoParts := strings.Split(cfg.DefaultRepository(), "/")
 		if len(repoParts) != 2 {
-			return fmt.Errorf("invalid repository format in config: %s", cfg.Repositories[0])
+			return fmt.Errorf("invalid repository format in config: %s", cfg.DefaultRepository())
 		}
 		owner, repo = repoParts[0], repoParts[1]
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return runCommentWithDeps(cmd, opts, client, owner, repo)
 }
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/rubrical-studios/gh-pmu/internal/api"
+	"github.com/rubrical-studios/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// completionFunc is the signature cobra uses for dynamic flag completion
+type completionFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// completeFieldAliases offers the value aliases configured for fieldKey
+// (e.g. backlog, in_progress for "status"). It only reads .gh-pmu.yml, so
+// completion never waits on the network.
+func completeFieldAliases(fieldKey string) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		cfg := loadCompletionConfig()
+		if cfg == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		aliases := make([]string, 0, len(cfg.Fields[fieldKey].Values))
+		for alias := range cfg.Fields[fieldKey].Values {
+			aliases = append(aliases, alias)
+		}
+		return filterCompletions(aliases, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeGroupByFields offers the configured field aliases plus the
+// built-in pseudo-fields accepted by --group-by
+func completeGroupByFields(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cfg := loadCompletionConfig()
+	if cfg == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	names := []string{api.MilestoneFieldName, api.RepositoryFieldName}
+	for alias := range cfg.Fields {
+		names = append(names, alias)
+	}
+	return filterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// loadCompletionConfig loads the config for completion, returning nil rather
+// than an error when there is none
+func loadCompletionConfig() *config.Config {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+	cfg, err := config.LoadProfileFromDirectory(cwd, configProfile())
+	if err != nil {
+		return nil
+	}
+	return cfg
+}
+
+// filterCompletions returns the sorted candidates that start with prefix
+func filterCompletions(candidates []string, prefix string) []string {
+	var matches []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			matches = append(matches, c)
+		}
+	}
+	sort.Strings(matches)
+	return matches
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/rubrical-studios/gh-pmu/internal/api"
+	"github.com/spf13/cobra"
+)
+
+func TestCompleteFieldAliases_ReturnsConfiguredAliases(t *testing.T) {
+	// ARRANGE
+	cfg := testBranchConfig()
+	cfg.Fields["status"].Values["backlog"] = "Backlog"
+	cfg.Fields["status"].Values["in_review"] = "In review"
+	cleanup := setupBranchTestDir(t, cfg)
+	defer cleanup()
+
+	// ACT
+	got, directive := completeFieldAliases("status")(&cobra.Command{}, nil, "in")
+
+	// ASSERT
+	want := []string{"in_progress", "in_review"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("Expected NoFileComp directive, got %v", directive)
+	}
+}
+
+func TestCompleteFieldAliases_NoConfigOffersNothing(t *testing.T) {
+	// ARRANGE
+	originalDir, _ := os.Getwd()
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatalf("Failed to chdir: %v", err)
+	}
+	defer func() { _ = os.Chdir(originalDir) }()
+
+	// ACT
+	got, directive := completeFieldAliases("status")(&cobra.Command{}, nil, "")
+
+	// ASSERT
+	if len(got) != 0 {
+		t.Errorf("Expected no completions, got %v", got)
+	}
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("Expected NoFileComp directive, got %v", directive)
+	}
+}
+
+func TestCompleteGroupByFields_IncludesPseudoFields(t *testing.T) {
+	// ARRANGE
+	cleanup := setupBranchTestDir(t, testBranchConfig())
+	defer cleanup()
+
+	// ACT
+	got, _ := completeGroupByFields(&cobra.Command{}, nil, "")
+
+	// ASSERT
+	want := []string{api.MilestoneFieldName, api.RepositoryFieldName, "status"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestMoveCommand_StatusFlagHasCompletion(t *testing.T) {
+	cmd := NewRootCommand()
+	moveCmd, _, err := cmd.Find([]string{"move"})
+	if err != nil {
+		t.Fatalf("move command not found: %v", err)
+	}
+
+	if _, ok := moveCmd.GetFlagCompletionFunc("status"); !ok {
+		t.Error("Expected completion function for --status")
+	}
+}
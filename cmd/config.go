@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/rubrical-studios/gh-pmu/internal/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// configInitClient defines the interface for API methods used by config init.
+// This allows for easier testing with mock implementations.
+type configInitClient interface {
+	ListProjects(owner string) ([]api.Project, error)
+	GetProjectFields(projectID string) ([]api.ProjectField, error)
+}
+
+// configPrompter asks the config init questions. It is a field on the options
+// so tests can script answers.
+type configPrompter interface {
+	Ask(question, defaultValue string) (string, error)
+}
+
+// configInitOptions holds the options for the config init command
+type configInitOptions struct {
+	owner        string
+	project      int
+	repos        []string
+	force        bool
+	interactive  bool   // Prompt for values not given as flags
+	detectedRepo string // owner/repo from the git remote, offered as the default
+	prompter     configPrompter
+}
+
+func newConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the gh-pmu configuration file",
+		Long: `Author the .gh-pmu.yml configuration file.
+
+Unlike init, config commands never create projects, fields, or labels.`,
+	}
+
+	cmd.AddCommand(newConfigInitCommand())
+
+	return cmd
+}
+
+func newConfigInitCommand() *cobra.Command {
+	opts := &configInitOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Write .gh-pmu.yml with a guided wizard",
+		Long: `Write .gh-pmu.yml by answering a few questions: the project owner, the
+project (chosen from the owner's projects), the repositories, and which of
+the project's single-select fields to map.
+
+Values given as flags are not asked for. Without a terminal, --owner,
+--project, and --repo are required and every detected field is mapped.
+
+Examples:
+  gh pmu config init
+  gh pmu config init --owner my-org --project 5 --repo my-org/app`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cwd, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get current directory: %w", err)
+			}
+			opts.interactive = term.IsTerminal(int(os.Stdin.Fd()))
+			opts.detectedRepo = detectRepository()
+			return runConfigInitWithDeps(cmd, opts, cwd, newAPIClient())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.owner, "owner", "", "Project owner (user or organization)")
+	cmd.Flags().IntVar(&opts.project, "project", 0, "Project number")
+	cmd.Flags().StringSliceVar(&opts.repos, "repo", nil, "Repository in owner/repo format (repeatable)")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "Overwrite an existing .gh-pmu.yml")
+
+	return cmd
+}
+
+// runConfigInitWithDeps gathers the config values from flags and prompts and
+// writes .gh-pmu.yml to dir. It receives all dependencies as parameters for
+// easy mocking.
+func runConfigInitWithDeps(cmd *cobra.Command, opts *configInitOptions, dir string, client configInitClient) error {
+	if _, err := os.Stat(filepath.Join(dir, ".gh-pmu.yml")); err == nil && !opts.force {
+		return fmt.Errorf(".gh-pmu.yml already exists (use --force to overwrite)")
+	}
+
+	if !opts.interactive {
+		var missing []string
+		if opts.owner == "" {
+			missing = append(missing, "--owner")
+		}
+		if opts.project == 0 {
+			missing = append(missing, "--project")
+		}
+		if len(opts.repos) == 0 {
+			missing = append(missing, "--repo")
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("not running in a terminal; config init requires flags: %s", strings.Join(missing, ", "))
+		}
+	}
+	prompter := opts.prompter
+	if prompter == nil {
+		prompter = newReaderPrompter(cmd.InOrStdin(), cmd.OutOrStdout())
+	}
+	out := cmd.OutOrStdout()
+
+	// Owner
+	owner := opts.owner
+	if owner == "" {
+		defaultOwner, _ := splitRepository(opts.detectedRepo)
+		answer, err := prompter.Ask("Project owner", defaultOwner)
+		if err != nil {
+			return err
+		}
+		owner = answer
+	}
+	if owner == "" {
+		return fmt.Errorf("project owner is required")
+	}
+
+	// Project
+	projects, err := client.ListProjects(owner)
+	if err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+	number := opts.project
+	if number == 0 {
+		if len(projects) == 0 {
+			return fmt.Errorf("no projects found for %s", owner)
+		}
+		fmt.Fprintf(out, "Projects for %s:\n", owner)
+		for _, p := range projects {
+			fmt.Fprintf(out, "  #%d %s\n", p.Number, p.Title)
+		}
+		answer, err := prompter.Ask("Project number", strconv.Itoa(projects[0].Number))
+		if err != nil {
+			return err
+		}
+		number, err = strconv.Atoi(strings.TrimPrefix(answer, "#"))
+		if err != nil {
+			return fmt.Errorf("invalid project number: %s", answer)
+		}
+	}
+	var project *api.Project
+	for i := range projects {
+		if projects[i].Number == number {
+			project = &projects[i]
+			break
+		}
+	}
+	if project == nil {
+		return fmt.Errorf("project #%d not found for %s", number, owner)
+	}
+
+	// Repositories
+	repos := opts.repos
+	if len(repos) == 0 {
+		answer, err := prompter.Ask("Repositories (owner/repo, comma-separated)", opts.detectedRepo)
+		if err != nil {
+			return err
+		}
+		repos = splitCommaList(answer)
+	}
+	if len(repos) == 0 {
+		return fmt.Errorf("at least one repository is required")
+	}
+	for _, repo := range repos {
+		if repoOwner, repoName := splitRepository(repo); repoOwner == "" || repoName == "" {
+			return fmt.Errorf("invalid repository %q: expected owner/repo", repo)
+		}
+	}
+
+	// Fields
+	fields, err := client.GetProjectFields(project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get project fields: %w", err)
+	}
+	var detected []string
+	for _, f := range fields {
+		if f.DataType == "SINGLE_SELECT" && len(f.Options) > 0 {
+			detected = append(detected, f.Name)
+		}
+	}
+	selected := detected
+	if opts.interactive && len(detected) > 0 {
+		answer, err := prompter.Ask("Fields to map (comma-separated)", strings.Join(detected, ", "))
+		if err != nil {
+			return err
+		}
+		selected = splitCommaList(answer)
+	}
+
+	metadata := projectMetadataFromFields(project.ID, fields)
+	mappings, err := configFieldMappings(fields, selected)
+	if err != nil {
+		return err
+	}
+
+	initCfg := &InitConfig{
+		ProjectName:   project.Title,
+		ProjectOwner:  owner,
+		ProjectNumber: project.Number,
+		Repositories:  repos,
+		Fields:        mappings,
+	}
+	if err := writeConfigWithMetadata(dir, initCfg, metadata); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+
+	fmt.Fprintf(out, "Created .gh-pmu.yml for %s (#%d)\n", project.Title, project.Number)
+	return nil
+}
+
+// configFieldMappings maps each selected single-select field under an alias
+// derived from its name (e.g. "Story Type" -> story_type). Status and
+// priority fall back to the defaults init uses when not selected, since
+// other commands rely on them.
+func configFieldMappings(fields []api.ProjectField, selected []string) (map[string]FieldMapping, error) {
+	var chosen []api.ProjectField
+	for _, name := range selected {
+		field := findFieldByName(fields, name)
+		if field == nil || field.DataType != "SINGLE_SELECT" {
+			return nil, fmt.Errorf("no single-select field %q in project", name)
+		}
+		chosen = append(chosen, *field)
+	}
+
+	mappings := buildFieldMappingsFromMetadata(projectMetadataFromFields("", chosen))
+	for _, field := range chosen {
+		alias := optionNameToAlias(field.Name)
+		if alias == "status" || alias == "priority" {
+			continue
+		}
+		values := make(map[string]string)
+		for _, opt := range field.Options {
+			values[optionNameToAlias(opt.Name)] = opt.Name
+		}
+		mappings[alias] = FieldMapping{Field: field.Name, Values: values}
+	}
+	return mappings, nil
+}
+
+// splitCommaList splits a comma-separated answer, dropping empty entries
+func splitCommaList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
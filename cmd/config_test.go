@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rubrical-studios/gh-pmu/internal/api"
+	"github.com/rubrical-studios/gh-pmu/internal/config"
+)
+
+// mockConfigInitClient serves a fixed project list and field set
+type mockConfigInitClient struct {
+	projects []api.Project
+	fields   []api.ProjectField
+}
+
+func (m *mockConfigInitClient) ListProjects(owner string) ([]api.Project, error) {
+	return m.projects, nil
+}
+
+func (m *mockConfigInitClient) GetProjectFields(projectID string) ([]api.ProjectField, error) {
+	return m.fields, nil
+}
+
+// scriptedAsker answers Ask calls from a fixed list, recording questions
+type scriptedAsker struct {
+	answers   []string
+	questions []string
+}
+
+func (p *scriptedAsker) Ask(question, defaultValue string) (string, error) {
+	p.questions = append(p.questions, question)
+	if len(p.questions) > len(p.answers) || p.answers[len(p.questions)-1] == "" {
+		return defaultValue, nil
+	}
+	return p.answers[len(p.questions)-1], nil
+}
+
+func newMockConfigInitClient() *mockConfigInitClient {
+	return &mockConfigInitClient{
+		projects: []api.Project{
+			{ID: "PVT_1", Number: 1, Title: "Roadmap"},
+			{ID: "PVT_7", Number: 7, Title: "Delivery"},
+		},
+		fields: []api.ProjectField{
+			{ID: "F_STATUS", Name: "Status", DataType: "SINGLE_SELECT", Options: []api.FieldOption{
+				{ID: "O1", Name: "Backlog"}, {ID: "O2", Name: "In progress"}, {ID: "O3", Name: "Done"},
+			}},
+			{ID: "F_PRIORITY", Name: "Priority", DataType: "SINGLE_SELECT", Options: []api.FieldOption{
+				{ID: "O4", Name: "P0"}, {ID: "O5", Name: "P1"},
+			}},
+			{ID: "F_TYPE", Name: "Story Type", DataType: "SINGLE_SELECT", Options: []api.FieldOption{
+				{ID: "O6", Name: "Feature"}, {ID: "O7", Name: "Bug"},
+			}},
+			{ID: "F_NOTES", Name: "Notes", DataType: "TEXT"},
+		},
+	}
+}
+
+func TestRunConfigInitWithDeps_ScriptedAnswers(t *testing.T) {
+	// ARRANGE
+	dir := t.TempDir()
+	prompter := &scriptedAsker{answers: []string{"", "7", "acme/app, acme/lib", "Status, Story Type"}}
+	opts := &configInitOptions{interactive: true, detectedRepo: "acme/app", prompter: prompter}
+	cmd, buf := newTestBranchCmd()
+
+	// ACT
+	err := runConfigInitWithDeps(cmd, opts, dir, newMockConfigInitClient())
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.Contains(buf.String(), "#7 Delivery") {
+		t.Errorf("Expected the project list, got: %s", buf.String())
+	}
+	cfg, err := config.Load(filepath.Join(dir, ".gh-pmu.yml"))
+	if err != nil {
+		t.Fatalf("Failed to load written config: %v", err)
+	}
+	if cfg.Project.Owner != "acme" || cfg.Project.Number != 7 || cfg.Project.Name != "Delivery" {
+		t.Errorf("Unexpected project: %+v", cfg.Project)
+	}
+	if !reflect.DeepEqual(cfg.Repositories, []string{"acme/app", "acme/lib"}) {
+		t.Errorf("Unexpected repositories: %v", cfg.Repositories)
+	}
+	if got := cfg.Fields["story_type"]; got.Field != "Story Type" || got.Values["bug"] != "Bug" {
+		t.Errorf("Expected story_type mapping, got %+v", got)
+	}
+	if got := cfg.Fields["status"]; got.Values["in_progress"] != "In progress" {
+		t.Errorf("Expected status mapped from the project, got %+v", got)
+	}
+	if cfg.Metadata.Project.ID != "PVT_7" {
+		t.Errorf("Expected metadata for PVT_7, got %q", cfg.Metadata.Project.ID)
+	}
+}
+
+func TestRunConfigInitWithDeps_FlagsSkipPrompts(t *testing.T) {
+	// ARRANGE
+	dir := t.TempDir()
+	prompter := &scriptedAsker{}
+	opts := &configInitOptions{owner: "acme", project: 1, repos: []string{"acme/app"}, prompter: prompter}
+	cmd, _ := newTestBranchCmd()
+
+	// ACT
+	err := runConfigInitWithDeps(cmd, opts, dir, newMockConfigInitClient())
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(prompter.questions) != 0 {
+		t.Errorf("Expected no prompts, got %v", prompter.questions)
+	}
+	cfg, err := config.Load(filepath.Join(dir, ".gh-pmu.yml"))
+	if err != nil {
+		t.Fatalf("Failed to load written config: %v", err)
+	}
+	if _, ok := cfg.Fields["story_type"]; !ok {
+		t.Errorf("Expected every detected field mapped, got %v", cfg.Fields)
+	}
+}
+
+func TestRunConfigInitWithDeps_NonTTYRequiresFlags(t *testing.T) {
+	cmd, _ := newTestBranchCmd()
+	opts := &configInitOptions{owner: "acme"}
+
+	err := runConfigInitWithDeps(cmd, opts, t.TempDir(), newMockConfigInitClient())
+
+	if err == nil || !strings.Contains(err.Error(), "--project, --repo") {
+		t.Errorf("Expected missing flags error, got: %v", err)
+	}
+}
+
+func TestRunConfigInitWithDeps_ExistingConfigRequiresForce(t *testing.T) {
+	// ARRANGE
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".gh-pmu.yml")
+	if err := os.WriteFile(path, []byte("project:\n  owner: old\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	opts := &configInitOptions{owner: "acme", project: 1, repos: []string{"acme/app"}}
+	cmd, _ := newTestBranchCmd()
+
+	// ACT
+	err := runConfigInitWithDeps(cmd, opts, dir, newMockConfigInitClient())
+
+	// ASSERT
+	if err == nil || !strings.Contains(err.Error(), "use --force") {
+		t.Fatalf("Expected --force error, got: %v", err)
+	}
+	opts.force = true
+	if err := runConfigInitWithDeps(cmd, opts, dir, newMockConfigInitClient()); err != nil {
+		t.Fatalf("Expected --force to overwrite, got: %v", err)
+	}
+}
+
+func TestRunConfigInitWithDeps_UnknownProject(t *testing.T) {
+	cmd, _ := newTestBranchCmd()
+	opts := &configInitOptions{owner: "acme", project: 99, repos: []string{"acme/app"}}
+
+	err := runConfigInitWithDeps(cmd, opts, t.TempDir(), newMockConfigInitClient())
+
+	if err == nil || !strings.Contains(err.Error(), "project #99 not found") {
+		t.Errorf("Expected not found error, got: %v", err)
+	}
+}
+
+func TestReaderPrompter_Ask(t *testing.T) {
+	var out strings.Builder
+	p := newReaderPrompter(strings.NewReader("acme\n\n"), &out)
+
+	first, _ := p.Ask("Project owner", "")
+	second, _ := p.Ask("Project number", "7")
+
+	if first != "acme" || second != "7" {
+		t.Errorf("Expected answers acme and default 7, got %q and %q", first, second)
+	}
+	if out.String() != "Project owner: Project number [7]: " {
+		t.Errorf("Unexpected prompts: %q", out.String())
+	}
+}
@@ -74,6 +74,8 @@ any specified field values (status, priority) are set.`,
 	cmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open the browser after creating the issue")
 	cmd.Flags().StringVarP(&opts.status, "status", "s", "", "Set project status field (e.g., backlog, in_progress)")
 	cmd.Flags().StringVarP(&opts.priority, "priority", "p", "", "Set project priority field (e.g., p0, p1, p2)")
+	_ = cmd.RegisterFlagCompletionFunc("status", completeFieldAliases("status"))
+	_ = cmd.RegisterFlagCompletionFunc("priority", completeFieldAliases("priority"))
 	cmd.Flags().StringVar(&opts.release, "branch", "", "Set branch field (use 'current' for active branch)")
 	cmd.Flags().StringVarP(&opts.release, "release", "r", "", "[DEPRECATED] Use --branch instead")
 	cmd.MarkFlagsMutuallyExclusive("branch", "release")
@@ -104,7 +106,7 @@ func runCreate(cmd *cobra.Command, opts *createOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -127,15 +129,15 @@ func runCreate(cmd *cobra.Command, opts *createOptions) error {
 		if len(cfg.Repositories) == 0 {
 			return fmt.Errorf("no repository configured")
 		}
-		repoParts := strings.Split(cfg.Repositories[0], "/")
+		repoParts := strings.Split(cfg.DefaultRepository(), "/")
 		if len(repoParts) != 2 {
-			return fmt.Errorf("invalid repository format in config: %s", cfg.Repositories[0])
+			return fmt.Errorf("invalid repository format in config: %s", cfg.DefaultRepository())
 		}
 		owner, repo = repoParts[0], repoParts[1]
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return runCreateWithDeps(cmd, opts, cfg, client, owner, repo)
 }
@@ -206,7 +208,7 @@ func runCreateWithDeps(cmd *cobra.Command, opts *createOptions, cfg *config.Conf
 	// IDPF validation for create
 	if cfg.IsIDPF() && opts.status != "" {
 		statusValue := cfg.ResolveFieldValue("status", opts.status)
-		if err := validateCreateOptions(statusValue, body, opts.release); err != nil {
+		if err := validateCreateOptions(cfg, statusValue, body, opts.release); err != nil {
 			return err
 		}
 	}
@@ -272,7 +274,7 @@ func runCreateWithDeps(cmd *cobra.Command, opts *createOptions, cfg *config.Conf
 		releaseValue := opts.release
 		if opts.release == "current" {
 			// Resolve "current" to active branch
-			releaseIssues, err := client.GetOpenIssuesByLabel(owner, repo, "branch")
+			releaseIssues, err := client.GetOpenIssuesByLabel(owner, repo, cfg.GetBranchLabel())
 			if err != nil {
 				return fmt.Errorf("failed to get branch issues: %w", err)
 			}
@@ -406,18 +408,18 @@ func runCreateFromFileWithDeps(cmd *cobra.Command, opts *createOptions, cfg *con
 }
 
 // validateCreateOptions validates IDPF rules for issue creation
-func validateCreateOptions(status, body, release string) error {
-	statusLower := strings.ToLower(status)
+func validateCreateOptions(cfg *config.Config, status, body, release string) error {
+	isDone := isDoneStatusValue(cfg, status)
 
 	// Rule 1: Body required for in_review/done
-	if statusLower == "in_review" || statusLower == "in review" || statusLower == "done" {
+	if requiresCompleteBody(cfg, status) {
 		if isBodyEmpty(body) {
 			return fmt.Errorf("cannot create issue with status '%s' without body content", status)
 		}
 	}
 
 	// Rule 2: All checkboxes must be checked for done
-	if statusLower == "done" {
+	if isDone {
 		unchecked := countUncheckedBoxes(body)
 		if unchecked > 0 {
 			return fmt.Errorf("cannot create issue as 'done' with %d unchecked checkbox(es)", unchecked)
@@ -425,10 +427,9 @@ func validateCreateOptions(status, body, release string) error {
 	}
 
 	// Rule 3: Release required for ready/in_progress/in_review/done
-	requiresRelease := statusLower == "ready" ||
-		statusLower == "in progress" || statusLower == "in_progress" ||
-		statusLower == "in_review" || statusLower == "in review" ||
-		statusLower == "done"
+	requiresRelease := cfg.IsStatus(status, cfg.ResolveFieldValue("status", "ready")) ||
+		cfg.IsInProgressStatus(status) ||
+		requiresCompleteBody(cfg, status)
 	if requiresRelease && release == "" {
 		return fmt.Errorf("cannot create issue with status '%s' without --branch flag\nUse: gh pmu create --status %s --branch \"release/vX.Y.Z\"", status, strings.ToLower(status))
 	}
@@ -1101,7 +1101,7 @@ repositories:
 // ============================================================================
 
 func TestValidateCreateOptions_ReadyWithoutRelease(t *testing.T) {
-	err := validateCreateOptions("Ready", "Issue body content", "")
+	err := validateCreateOptions(&config.Config{}, "Ready", "Issue body content", "")
 	if err == nil {
 		t.Fatal("Expected error for Ready status without release")
 	}
@@ -1112,21 +1112,21 @@ func TestValidateCreateOptions_ReadyWithoutRelease(t *testing.T) {
 }
 
 func TestValidateCreateOptions_InProgressWithoutRelease(t *testing.T) {
-	err := validateCreateOptions("In Progress", "Issue body content", "")
+	err := validateCreateOptions(&config.Config{}, "In Progress", "Issue body content", "")
 	if err == nil {
 		t.Fatal("Expected error for In Progress status without release")
 	}
 }
 
 func TestValidateCreateOptions_ReadyWithRelease(t *testing.T) {
-	err := validateCreateOptions("Ready", "Issue body content", "v1.0.0")
+	err := validateCreateOptions(&config.Config{}, "Ready", "Issue body content", "v1.0.0")
 	if err != nil {
 		t.Errorf("Expected no error for Ready with release, got: %v", err)
 	}
 }
 
 func TestValidateCreateOptions_InReviewWithoutBody(t *testing.T) {
-	err := validateCreateOptions("In Review", "", "")
+	err := validateCreateOptions(&config.Config{}, "In Review", "", "")
 	if err == nil {
 		t.Fatal("Expected error for In Review without body")
 	}
@@ -1137,14 +1137,14 @@ func TestValidateCreateOptions_InReviewWithoutBody(t *testing.T) {
 }
 
 func TestValidateCreateOptions_DoneWithoutBody(t *testing.T) {
-	err := validateCreateOptions("Done", "", "")
+	err := validateCreateOptions(&config.Config{}, "Done", "", "")
 	if err == nil {
 		t.Fatal("Expected error for Done without body")
 	}
 }
 
 func TestValidateCreateOptions_DoneWithBodyWithoutBranch(t *testing.T) {
-	err := validateCreateOptions("Done", "Issue completed", "")
+	err := validateCreateOptions(&config.Config{}, "Done", "Issue completed", "")
 	if err == nil {
 		t.Fatal("Expected error for Done without branch")
 	}
@@ -1155,7 +1155,7 @@ func TestValidateCreateOptions_DoneWithBodyWithoutBranch(t *testing.T) {
 }
 
 func TestValidateCreateOptions_DoneWithBodyWithBranch(t *testing.T) {
-	err := validateCreateOptions("Done", "Issue completed", "v1.0.0")
+	err := validateCreateOptions(&config.Config{}, "Done", "Issue completed", "v1.0.0")
 	if err != nil {
 		t.Errorf("Expected no error for Done with body and branch, got: %v", err)
 	}
@@ -1166,7 +1166,7 @@ func TestValidateCreateOptions_DoneWithUncheckedBoxes(t *testing.T) {
 - [x] First task
 - [ ] Unchecked task`
 
-	err := validateCreateOptions("Done", body, "")
+	err := validateCreateOptions(&config.Config{}, "Done", body, "")
 	if err == nil {
 		t.Fatal("Expected error for Done with unchecked boxes")
 	}
@@ -1181,14 +1181,14 @@ func TestValidateCreateOptions_DoneWithAllChecked(t *testing.T) {
 - [x] First task
 - [x] Second task`
 
-	err := validateCreateOptions("Done", body, "v1.0.0")
+	err := validateCreateOptions(&config.Config{}, "Done", body, "v1.0.0")
 	if err != nil {
 		t.Errorf("Expected no error for Done with all checked and branch, got: %v", err)
 	}
 }
 
 func TestValidateCreateOptions_InReviewWithoutBranch(t *testing.T) {
-	err := validateCreateOptions("In Review", "Issue body", "")
+	err := validateCreateOptions(&config.Config{}, "In Review", "Issue body", "")
 	if err == nil {
 		t.Fatal("Expected error for In Review without branch")
 	}
@@ -1199,7 +1199,7 @@ func TestValidateCreateOptions_InReviewWithoutBranch(t *testing.T) {
 }
 
 func TestValidateCreateOptions_InReviewWithBranch(t *testing.T) {
-	err := validateCreateOptions("In Review", "Issue body", "v1.0.0")
+	err := validateCreateOptions(&config.Config{}, "In Review", "Issue body", "v1.0.0")
 	if err != nil {
 		t.Errorf("Expected no error for In Review with body and branch, got: %v", err)
 	}
@@ -1207,7 +1207,7 @@ func TestValidateCreateOptions_InReviewWithBranch(t *testing.T) {
 
 func TestValidateCreateOptions_BacklogNoRequirements(t *testing.T) {
 	// Backlog has no validation requirements
-	err := validateCreateOptions("Backlog", "", "")
+	err := validateCreateOptions(&config.Config{}, "Backlog", "", "")
 	if err != nil {
 		t.Errorf("Expected no error for Backlog, got: %v", err)
 	}
@@ -1215,17 +1215,17 @@ func TestValidateCreateOptions_BacklogNoRequirements(t *testing.T) {
 
 func TestValidateCreateOptions_CaseInsensitive(t *testing.T) {
 	// Test various case combinations
-	err := validateCreateOptions("READY", "body", "")
+	err := validateCreateOptions(&config.Config{}, "READY", "body", "")
 	if err == nil {
 		t.Error("Expected error for READY without release")
 	}
 
-	err = validateCreateOptions("ready", "body", "v1.0")
+	err = validateCreateOptions(&config.Config{}, "ready", "body", "v1.0")
 	if err != nil {
 		t.Errorf("Expected no error for ready with release, got: %v", err)
 	}
 
-	err = validateCreateOptions("in_progress", "body", "")
+	err = validateCreateOptions(&config.Config{}, "in_progress", "body", "")
 	if err == nil {
 		t.Error("Expected error for in_progress without release")
 	}
@@ -2179,3 +2179,20 @@ func TestRunCreateWithDeps_BodyStdinAndBodyFileMutuallyExclusive(t *testing.T) {
 		t.Errorf("expected mutual exclusivity error, got: %v", err)
 	}
 }
+
+func TestValidateCreateOptions_ConfiguredSemanticValues(t *testing.T) {
+	cfg := &config.Config{Fields: map[string]config.Field{"status": {
+		Field:     "Status",
+		Semantics: &config.StatusSemantics{DoneValues: []string{"Shipped"}, InProgressValues: []string{"Doing"}},
+	}}}
+
+	if err := validateCreateOptions(cfg, "Shipped", "", "v1.0.0"); err == nil || !strings.Contains(err.Error(), "without body content") {
+		t.Errorf("Expected body required for a configured done value, got: %v", err)
+	}
+	if err := validateCreateOptions(cfg, "Doing", "Body", ""); err == nil || !strings.Contains(err.Error(), "without --branch flag") {
+		t.Errorf("Expected branch required for a configured in-progress value, got: %v", err)
+	}
+	if err := validateCreateOptions(cfg, "In progress", "Body", ""); err != nil {
+		t.Errorf("Expected the default in-progress value not to apply, got: %v", err)
+	}
+}
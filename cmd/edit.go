@@ -81,7 +81,7 @@ func runEdit(cmd *cobra.Command, opts *editOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -104,15 +104,15 @@ func runEdit(cmd *cobra.Command, opts *editOptions) error {
 		if len(cfg.Repositories) == 0 {
 			return fmt.Errorf("no repository configured")
 		}
-		repoParts := strings.Split(cfg.Repositories[0], "/")
+		repoParts := strings.Split(cfg.DefaultRepository(), "/")
 		if len(repoParts) != 2 {
-			return fmt.Errorf("invalid repository format in config: %s", cfg.Repositories[0])
+			return fmt.Errorf("invalid repository format in config: %s", cfg.DefaultRepository())
 		}
 		owner, repo = repoParts[0], repoParts[1]
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return runEditWithDeps(cmd, opts, cfg, client, owner, repo)
 }
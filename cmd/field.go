@@ -114,7 +114,7 @@ func runFieldCreate(cmd *cobra.Command, args []string, opts *fieldCreateOptions)
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -124,7 +124,7 @@ func runFieldCreate(cmd *cobra.Command, args []string, opts *fieldCreateOptions)
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return runFieldCreateWithDeps(cmd, fieldName, opts, cfg, client)
 }
@@ -214,7 +214,7 @@ func runFieldList(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -224,7 +224,7 @@ func runFieldList(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return runFieldListWithDeps(cmd, cfg, client)
 }
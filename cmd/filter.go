@@ -71,6 +71,8 @@ Example:
 
 	cmd.Flags().StringVarP(&opts.status, "status", "s", "", "Filter by status (e.g., backlog, ready, in_progress)")
 	cmd.Flags().StringVarP(&opts.priority, "priority", "p", "", "Filter by priority (e.g., p0, p1, p2)")
+	_ = cmd.RegisterFlagCompletionFunc("status", completeFieldAliases("status"))
+	_ = cmd.RegisterFlagCompletionFunc("priority", completeFieldAliases("priority"))
 	cmd.Flags().StringVarP(&opts.assignee, "assignee", "a", "", "Filter by assignee login")
 	cmd.Flags().StringVarP(&opts.label, "label", "l", "", "Filter by label name")
 	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format (default is table)")
@@ -91,7 +93,7 @@ func runFilter(cmd *cobra.Command, opts *filterOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -101,7 +103,7 @@ func runFilter(cmd *cobra.Command, opts *filterOptions) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return runFilterWithDeps(cmd, opts, cfg, client, os.Stdin)
 }
@@ -283,10 +285,10 @@ func buildIssueRefsFromInput(issues []FilterInput, cfg *config.Config) []api.Iss
 		// Try to parse repo from URL (format: https://github.com/owner/repo/issues/123)
 		owner, repo := parseRepoFromURL(issue.URL)
 
-		// Fall back to first configured repository if URL parsing fails
+		// Fall back to the primary repository if URL parsing fails
 		if owner == "" || repo == "" {
 			if len(cfg.Repositories) > 0 {
-				parts := strings.SplitN(cfg.Repositories[0], "/", 2)
+				parts := strings.SplitN(cfg.DefaultRepository(), "/", 2)
 				if len(parts) == 2 {
 					owner, repo = parts[0], parts[1]
 				}
@@ -150,7 +150,7 @@ func runHistory(cmd *cobra.Command, args []string, opts *historyOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -440,7 +440,7 @@ func parseCommitReferences(subject, defaultOwner, defaultRepo string) []IssueRef
 // parseRepoFromConfig extracts owner and repo from config
 func parseRepoFromConfig(cfg *config.Config) (string, string) {
 	if len(cfg.Repositories) > 0 {
-		parts := strings.Split(cfg.Repositories[0], "/")
+		parts := strings.Split(cfg.DefaultRepository(), "/")
 		if len(parts) == 2 {
 			return parts[0], parts[1]
 		}
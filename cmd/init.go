@@ -466,23 +466,24 @@ projectSelected:
 			}
 		}
 
-		// Migrate legacy "release" label to "branch" (if needed)
+		// Migrate legacy "release" label to the branch label (if needed)
+		branchLabel := loadExistingBranchLabel(".")
 		fmt.Fprintln(cmd.OutOrStdout())
 		u.Info("Checking for label migrations...")
 		legacyReleaseExists, _ := client.LabelExists(repoOwner, repoName, "release")
-		branchExists, _ := client.LabelExists(repoOwner, repoName, "branch")
+		branchExists, _ := client.LabelExists(repoOwner, repoName, branchLabel)
 
-		if legacyReleaseExists {
+		if legacyReleaseExists && branchLabel != "release" {
 			if !branchExists {
-				// Rename "release" to "branch"
-				spinner = ui.NewSpinner(cmd.OutOrStdout(), "Migrating 'release' label to 'branch'...")
+				// Rename "release" to the branch label
+				spinner = ui.NewSpinner(cmd.OutOrStdout(), fmt.Sprintf("Migrating 'release' label to '%s'...", branchLabel))
 				spinner.Start()
-				err := client.UpdateLabel(repoOwner, repoName, "release", "branch", "0e8a16", "Branch tracker issue")
+				err := client.UpdateLabel(repoOwner, repoName, "release", branchLabel, "0e8a16", "Branch tracker issue")
 				spinner.Stop()
 				if err != nil {
 					u.Warning(fmt.Sprintf("Could not migrate release label: %v", err))
 				} else {
-					u.Success("Migrated 'release' label to 'branch'")
+					u.Success(fmt.Sprintf("Migrated 'release' label to '%s'", branchLabel))
 				}
 			} else {
 				// Both exist - delete the legacy "release" label
@@ -501,7 +502,7 @@ projectSelected:
 		// Check and create required labels from defaults
 		fmt.Fprintln(cmd.OutOrStdout())
 		u.Info("Checking repository labels...")
-		for _, labelDef := range defs.Labels {
+		for _, labelDef := range withBranchLabel(defs.Labels, branchLabel) {
 			exists, err := client.LabelExists(repoOwner, repoName, labelDef.Name)
 			if err != nil {
 				u.Warning(fmt.Sprintf("Could not check %s label: %v", labelDef.Name, err))
@@ -740,7 +741,7 @@ func runInitNonInteractive(cmd *cobra.Command, opts *initOptions) error {
 		}
 
 		// Check and create required labels
-		for _, labelDef := range defs.Labels {
+		for _, labelDef := range withBranchLabel(defs.Labels, loadExistingBranchLabel(".")) {
 			exists, err := client.LabelExists(repoOwner, repoName, labelDef.Name)
 			if err != nil {
 				continue
@@ -755,23 +756,7 @@ func runInitNonInteractive(cmd *cobra.Command, opts *initOptions) error {
 	fields, _ := client.GetProjectFields(newProject.ID)
 
 	// Convert to metadata
-	metadata := &ProjectMetadata{
-		ProjectID: newProject.ID,
-	}
-	for _, f := range fields {
-		fm := FieldMetadata{
-			ID:       f.ID,
-			Name:     f.Name,
-			DataType: f.DataType,
-		}
-		for _, opt := range f.Options {
-			fm.Options = append(fm.Options, OptionMetadata{
-				ID:   opt.ID,
-				Name: opt.Name,
-			})
-		}
-		metadata.Fields = append(metadata.Fields, fm)
-	}
+	metadata := projectMetadataFromFields(newProject.ID, fields)
 
 	// Create config with NEW project number (not the source)
 	cfg := &InitConfig{
@@ -832,7 +817,8 @@ func detectRepository() string {
 
 // existingConfigRaw is used for YAML unmarshaling to get framework
 type existingConfigRaw struct {
-	Framework string `yaml:"framework"`
+	Framework string        `yaml:"framework"`
+	Labels    config.Labels `yaml:"labels"`
 }
 
 // loadExistingFramework loads framework from existing config
@@ -849,6 +835,32 @@ func loadExistingFramework(dir string) (string, error) {
 	return raw.Framework, nil
 }
 
+// loadExistingBranchLabel returns labels.branch from an existing config, or
+// the default branch label when there is none
+func loadExistingBranchLabel(dir string) string {
+	cfg := &config.Config{}
+	if data, err := os.ReadFile(filepath.Join(dir, ".gh-pmu.yml")); err == nil {
+		var raw existingConfigRaw
+		if yaml.Unmarshal(data, &raw) == nil {
+			cfg.Labels = &raw.Labels
+		}
+	}
+	return cfg.GetBranchLabel()
+}
+
+// withBranchLabel returns the default labels with the branch tracker label
+// renamed to branchLabel
+func withBranchLabel(labels []defaults.LabelDef, branchLabel string) []defaults.LabelDef {
+	renamed := make([]defaults.LabelDef, len(labels))
+	copy(renamed, labels)
+	for i := range renamed {
+		if renamed[i].Name == config.DefaultBranchLabel {
+			renamed[i].Name = branchLabel
+		}
+	}
+	return renamed
+}
+
 // splitRepository splits "owner/repo" into owner and repo parts.
 func splitRepository(repo string) (owner, name string) {
 	parts := strings.SplitN(repo, "/", 2)
@@ -870,6 +882,7 @@ type InitConfig struct {
 	ProjectNumber int
 	Repositories  []string
 	Framework     string
+	Fields        map[string]FieldMapping // Overrides the mappings built from metadata when set
 }
 
 // ConfigFile represents the .gh-pmu.yml file structure.
@@ -969,6 +982,7 @@ type ConfigFileWithMetadata struct {
 	Defaults     DefaultsConfig          `yaml:"defaults" json:"defaults"`
 	Fields       map[string]FieldMapping `yaml:"fields" json:"fields"`
 	Triage       map[string]TriageRule   `yaml:"triage,omitempty" json:"triage,omitempty"`
+	Labels       *config.Labels          `yaml:"labels,omitempty" json:"labels,omitempty"`
 	Acceptance   *config.Acceptance      `yaml:"acceptance,omitempty" json:"acceptance,omitempty"`
 	Metadata     MetadataSection         `yaml:"metadata" json:"metadata"`
 }
@@ -1083,7 +1097,10 @@ func writeConfigWithMetadata(dir string, cfg *InitConfig, metadata *ProjectMetad
 	}
 
 	// Build field mappings dynamically from metadata
-	fieldMappings := buildFieldMappingsFromMetadata(metadata)
+	fieldMappings := cfg.Fields
+	if fieldMappings == nil {
+		fieldMappings = buildFieldMappingsFromMetadata(metadata)
+	}
 
 	// Read existing acceptance from config before writing
 	var existingAcceptance *config.Acceptance
@@ -1094,6 +1111,12 @@ func writeConfigWithMetadata(dir string, cfg *InitConfig, metadata *ProjectMetad
 		}
 	}
 
+	// Keep a custom branch label across re-init
+	var labels *config.Labels
+	if branchLabel := loadExistingBranchLabel(dir); branchLabel != config.DefaultBranchLabel {
+		labels = &config.Labels{Branch: branchLabel}
+	}
+
 	configFile := &ConfigFileWithMetadata{
 		Version: getVersion(),
 		Project: ProjectConfig{
@@ -1117,6 +1140,7 @@ func writeConfigWithMetadata(dir string, cfg *InitConfig, metadata *ProjectMetad
 				},
 			},
 		},
+		Labels:     labels,
 		Acceptance: existingAcceptance,
 		Metadata: MetadataSection{
 			Project: MetadataProject{
@@ -1150,6 +1174,29 @@ func writeConfigWithMetadata(dir string, cfg *InitConfig, metadata *ProjectMetad
 	return nil
 }
 
+// projectMetadataFromFields converts project fields to the metadata cached in
+// the config file
+func projectMetadataFromFields(projectID string, fields []api.ProjectField) *ProjectMetadata {
+	metadata := &ProjectMetadata{
+		ProjectID: projectID,
+	}
+	for _, f := range fields {
+		fm := FieldMetadata{
+			ID:       f.ID,
+			Name:     f.Name,
+			DataType: f.DataType,
+		}
+		for _, opt := range f.Options {
+			fm.Options = append(fm.Options, OptionMetadata{
+				ID:   opt.ID,
+				Name: opt.Name,
+			})
+		}
+		metadata.Fields = append(metadata.Fields, fm)
+	}
+	return metadata
+}
+
 // buildFieldMappingsFromMetadata builds field mappings dynamically from project metadata.
 // This ensures all field options (including "Parking Lot") are included in the config.
 func buildFieldMappingsFromMetadata(metadata *ProjectMetadata) map[string]FieldMapping {
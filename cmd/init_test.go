@@ -11,6 +11,7 @@ import (
 
 	"github.com/rubrical-studios/gh-pmu/internal/api"
 	"github.com/rubrical-studios/gh-pmu/internal/config"
+	"github.com/rubrical-studios/gh-pmu/internal/defaults"
 )
 
 func TestInitCommand_Exists(t *testing.T) {
@@ -1917,3 +1918,53 @@ func TestWriteConfigWithMetadata_PreservesAcceptance_SameVersion(t *testing.T) {
 		t.Errorf("Expected version=%s, got %v", currentVersion, accMap["version"])
 	}
 }
+
+func TestLoadExistingBranchLabel(t *testing.T) {
+	tmpDir := t.TempDir()
+	if got := loadExistingBranchLabel(tmpDir); got != "branch" {
+		t.Errorf("Expected the default label without a config, got %q", got)
+	}
+
+	configContent := `
+project:
+  owner: test
+  number: 1
+labels:
+  branch: tracker
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, ".gh-pmu.yml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if got := loadExistingBranchLabel(tmpDir); got != "tracker" {
+		t.Errorf("Expected 'tracker', got %q", got)
+	}
+}
+
+func TestWithBranchLabel_RenamesOnlyTheBranchLabel(t *testing.T) {
+	labels := []defaults.LabelDef{{Name: "branch", Color: "0e8a16"}, {Name: "bug"}}
+
+	renamed := withBranchLabel(labels, "tracker")
+
+	if renamed[0].Name != "tracker" || renamed[0].Color != "0e8a16" || renamed[1].Name != "bug" {
+		t.Errorf("Expected only the branch label renamed, got %+v", renamed)
+	}
+	if labels[0].Name != "branch" {
+		t.Error("Expected the default labels left unchanged")
+	}
+}
+
+func TestWriteConfigWithMetadata_KeepsCustomBranchLabel(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, ".gh-pmu.yml"), []byte("labels:\n  branch: tracker\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	cfg := &InitConfig{ProjectOwner: "test", ProjectNumber: 1, Repositories: []string{"test/repo"}}
+
+	if err := writeConfigWithMetadata(tmpDir, cfg, &ProjectMetadata{ProjectID: "PVT_1"}); err != nil {
+		t.Fatalf("writeConfigWithMetadata failed: %v", err)
+	}
+
+	if got := loadExistingBranchLabel(tmpDir); got != "tracker" {
+		t.Errorf("Expected labels.branch kept across re-init, got %q", got)
+	}
+}
@@ -83,7 +83,7 @@ func runIntake(cmd *cobra.Command, opts *intakeOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -97,7 +97,7 @@ func runIntake(cmd *cobra.Command, opts *intakeOptions) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return runIntakeWithDeps(cmd, opts, cfg, client)
 }
@@ -0,0 +1,363 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rubrical-studios/gh-pmu/internal/api"
+	"github.com/rubrical-studios/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// issueSetClient defines the interface for API methods used by issue set/clear.
+// This allows for easier testing with mock implementations.
+type issueSetClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectFields(projectID string) ([]api.ProjectField, error)
+	GetProjectItemIDForIssue(projectID, owner, repo string, number int) (string, error)
+	SetProjectItemFieldWithFields(projectID, itemID, fieldName, value string, fields []api.ProjectField) error
+}
+
+type issueSetOptions struct {
+	repo string
+}
+
+// issueCommentsClient defines the interface for API methods used by issue
+// comments. This allows for easier testing with mock implementations.
+type issueCommentsClient interface {
+	GetIssueByNumber(owner, repo string, number int) (*api.Issue, error)
+	GetIssueComments(owner, repo string, number int) ([]api.Comment, error)
+	AddIssueComment(issueID, body string) (*api.Comment, error)
+}
+
+type issueCommentsOptions struct {
+	repo string
+	last int
+}
+
+func newIssueCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue",
+		Short: "Set or clear project fields on an issue",
+		Long: `Generic project field operations on a single issue.
+
+Use subcommands to set or clear any project field, or to list and add
+comments.`,
+	}
+
+	cmd.AddCommand(newIssueSetCommand())
+	cmd.AddCommand(newIssueClearCommand())
+	cmd.AddCommand(newIssueCommentsCommand())
+
+	return cmd
+}
+
+func newIssueSetCommand() *cobra.Command {
+	opts := &issueSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set <issue-number> <field> <value>",
+		Short: "Set a project field on an issue",
+		Long: `Set any project field on an issue that is in the configured project.
+
+The field may be a config alias (e.g. "status") or a project field name
+(e.g. "Release"). Values are resolved through config aliases, and
+single-select values must match one of the field's options.
+
+Examples:
+  gh pmu issue set 42 release v1.2.0
+  gh pmu issue set 42 status in_progress
+  gh pmu issue set owner/repo#42 "Story Points" 5`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadIssueSetConfig()
+			if err != nil {
+				return err
+			}
+			return runIssueSetWithDeps(cmd, args[0], args[1], args[2], opts, cfg, newAPIClient())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Repository for the issue (owner/repo format)")
+
+	return cmd
+}
+
+func newIssueClearCommand() *cobra.Command {
+	opts := &issueSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "clear <issue-number> <field>",
+		Short: "Clear a project field on an issue",
+		Long: `Remove the value of any project field on an issue.
+
+Examples:
+  gh pmu issue clear 42 release
+  gh pmu issue clear 42 priority`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadIssueSetConfig()
+			if err != nil {
+				return err
+			}
+			return runIssueSetWithDeps(cmd, args[0], args[1], "", opts, cfg, newAPIClient())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Repository for the issue (owner/repo format)")
+
+	return cmd
+}
+
+func newIssueCommentsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "comments",
+		Short: "List or add comments on an issue",
+	}
+
+	cmd.AddCommand(newIssueCommentsListCommand())
+	cmd.AddCommand(newIssueCommentsAddCommand())
+
+	return cmd
+}
+
+func newIssueCommentsListCommand() *cobra.Command {
+	opts := &issueCommentsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list <issue-number>",
+		Short: "List the comments on an issue",
+		Long: `Print each comment on an issue with its author and date, oldest first.
+
+Examples:
+  gh pmu issue comments list 42
+  gh pmu issue comments list 42 --last 3`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadIssueSetConfig()
+			if err != nil {
+				return err
+			}
+			return runIssueCommentsListWithDeps(cmd, args[0], opts, cfg, newAPIClient())
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.last, "last", 0, "Show only the last N comments")
+	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Repository for the issue (owner/repo format)")
+
+	return cmd
+}
+
+func newIssueCommentsAddCommand() *cobra.Command {
+	opts := &issueCommentsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add <issue-number> <text>",
+		Short: "Add a comment to an issue",
+		Long: `Add a comment to an issue. For comments from a file or stdin, use
+'gh pmu comment'.
+
+Examples:
+  gh pmu issue comments add 42 "Deployed to staging"`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadIssueSetConfig()
+			if err != nil {
+				return err
+			}
+			return runIssueCommentsAddWithDeps(cmd, args[0], args[1], opts, cfg, newAPIClient())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Repository for the issue (owner/repo format)")
+
+	return cmd
+}
+
+// runIssueCommentsListWithDeps prints the issue's comments, the last
+// opts.last of them when set. It receives all dependencies as parameters for
+// easy mocking.
+func runIssueCommentsListWithDeps(cmd *cobra.Command, issueArg string, opts *issueCommentsOptions, cfg *config.Config, client issueCommentsClient) error {
+	if opts.last < 0 {
+		return fmt.Errorf("--last must be a positive number")
+	}
+	owner, repo, number, err := resolveIssueArg(issueArg, opts.repo, cfg)
+	if err != nil {
+		return err
+	}
+
+	comments, err := client.GetIssueComments(owner, repo, number)
+	if err != nil {
+		return err
+	}
+	if opts.last > 0 && len(comments) > opts.last {
+		comments = comments[len(comments)-opts.last:]
+	}
+
+	out := cmd.OutOrStdout()
+	if len(comments) == 0 {
+		fmt.Fprintf(out, "No comments on #%d\n", number)
+		return nil
+	}
+	for i, c := range comments {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+		fmt.Fprintf(out, "@%s commented on %s:\n", c.Author, c.CreatedAt)
+		fmt.Fprintln(out, c.Body)
+	}
+	return nil
+}
+
+// runIssueCommentsAddWithDeps posts body as a comment on the issue. It
+// receives all dependencies as parameters for easy mocking.
+func runIssueCommentsAddWithDeps(cmd *cobra.Command, issueArg, body string, opts *issueCommentsOptions, cfg *config.Config, client issueCommentsClient) error {
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("comment body cannot be empty")
+	}
+	owner, repo, number, err := resolveIssueArg(issueArg, opts.repo, cfg)
+	if err != nil {
+		return err
+	}
+
+	issue, err := client.GetIssueByNumber(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue #%d: %w", number, err)
+	}
+
+	comment, err := client.AddIssueComment(issue.ID, body)
+	if err != nil {
+		return fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Added comment to issue #%d\n", number)
+	fmt.Fprintf(cmd.OutOrStdout(), "%s#issuecomment-%d\n", issue.URL, comment.DatabaseId)
+	return nil
+}
+
+func loadIssueSetConfig() (*config.Config, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current directory: %w", err)
+	}
+
+	cfg, err := loadConfig(cwd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// runIssueSetWithDeps sets fieldArg on the issue to value, or clears it when
+// value is empty. It receives all dependencies as parameters for easy mocking.
+func runIssueSetWithDeps(cmd *cobra.Command, issueArg, fieldArg, value string, opts *issueSetOptions, cfg *config.Config, client issueSetClient) error {
+	owner, repo, number, err := resolveIssueArg(issueArg, opts.repo, cfg)
+	if err != nil {
+		return err
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	fields, err := client.GetProjectFields(project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get project fields: %w", err)
+	}
+
+	field, alias, err := resolveIssueSetField(cfg, fields, fieldArg)
+	if err != nil {
+		return err
+	}
+
+	if value != "" {
+		if alias != "" {
+			value = cfg.ResolveFieldValue(alias, value)
+		}
+		if field.DataType == "SINGLE_SELECT" && !hasFieldOption(field, value) {
+			names := make([]string, len(field.Options))
+			for i, opt := range field.Options {
+				names[i] = opt.Name
+			}
+			return fmt.Errorf("invalid value %q for field %q\nValid options: %s", value, field.Name, strings.Join(names, ", "))
+		}
+	}
+
+	itemID, err := client.GetProjectItemIDForIssue(project.ID, owner, repo, number)
+	if err != nil {
+		return err
+	}
+
+	if err := client.SetProjectItemFieldWithFields(project.ID, itemID, field.Name, value, fields); err != nil {
+		return err
+	}
+
+	if value == "" {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Cleared %s on #%d\n", field.Name, number)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "✓ Set %s to %q on #%d\n", field.Name, value, number)
+	}
+
+	return nil
+}
+
+// resolveIssueArg parses an issue number, owner/repo#N, or URL. A bare
+// number belongs to the --repo repository, or else the configured one.
+func resolveIssueArg(issueArg, repoFlag string, cfg *config.Config) (owner, repo string, number int, err error) {
+	owner, repo, number, err = parseIssueReference(issueArg)
+	if err != nil {
+		return "", "", 0, err
+	}
+	if owner == "" || repo == "" {
+		if repoFlag != "" {
+			parts := strings.Split(repoFlag, "/")
+			if len(parts) != 2 {
+				return "", "", 0, fmt.Errorf("invalid --repo format: expected owner/repo, got %s", repoFlag)
+			}
+			owner, repo = parts[0], parts[1]
+		} else if owner, repo, err = parseOwnerRepo(cfg); err != nil {
+			return "", "", 0, err
+		}
+	}
+	return owner, repo, number, nil
+}
+
+// resolveIssueSetField finds the project field named by a config alias or a
+// project field name, both case-insensitive. The returned alias is the config
+// key used to resolve value aliases, or empty when the name matched no alias.
+func resolveIssueSetField(cfg *config.Config, fields []api.ProjectField, name string) (*api.ProjectField, string, error) {
+	fieldName, alias := name, ""
+	for key, f := range cfg.Fields {
+		if strings.EqualFold(key, name) || (f.Field != "" && strings.EqualFold(f.Field, name)) {
+			alias = key
+			if f.Field != "" {
+				fieldName = f.Field
+			}
+			break
+		}
+	}
+
+	for i := range fields {
+		if strings.EqualFold(fields[i].Name, fieldName) {
+			return &fields[i], alias, nil
+		}
+	}
+	return nil, "", fmt.Errorf("field %q not found in project", fieldName)
+}
+
+// hasFieldOption reports whether a single-select field has an option named value
+func hasFieldOption(field *api.ProjectField, value string) bool {
+	for _, opt := range field.Options {
+		if opt.Name == value {
+			return true
+		}
+	}
+	return false
+}
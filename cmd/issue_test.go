@@ -0,0 +1,333 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rubrical-studios/gh-pmu/internal/api"
+	"github.com/rubrical-studios/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// mockIssueSetClient implements issueSetClient for testing
+type mockIssueSetClient struct {
+	project    *api.Project
+	fields     []api.ProjectField
+	itemID     string
+	getItemErr error
+
+	// Captured calls
+	itemLookups []string
+	setCalls    []setFieldCall
+}
+
+func (m *mockIssueSetClient) GetProject(owner string, number int) (*api.Project, error) {
+	return m.project, nil
+}
+
+func (m *mockIssueSetClient) GetProjectFields(projectID string) ([]api.ProjectField, error) {
+	return m.fields, nil
+}
+
+func (m *mockIssueSetClient) GetProjectItemIDForIssue(projectID, owner, repo string, number int) (string, error) {
+	m.itemLookups = append(m.itemLookups, owner+"/"+repo)
+	if m.getItemErr != nil {
+		return "", m.getItemErr
+	}
+	return m.itemID, nil
+}
+
+func (m *mockIssueSetClient) SetProjectItemFieldWithFields(projectID, itemID, fieldName, value string, fields []api.ProjectField) error {
+	m.setCalls = append(m.setCalls, setFieldCall{projectID: projectID, itemID: itemID, fieldID: fieldName, value: value})
+	return nil
+}
+
+func newMockIssueSetClient() *mockIssueSetClient {
+	return &mockIssueSetClient{
+		project: &api.Project{ID: "PROJ_1"},
+		fields: []api.ProjectField{
+			{ID: "F_STATUS", Name: "Status", DataType: "SINGLE_SELECT", Options: []api.FieldOption{
+				{ID: "O_1", Name: "Backlog"},
+				{ID: "O_2", Name: "In progress"},
+			}},
+			{ID: "F_RELEASE", Name: "Release", DataType: "TEXT"},
+		},
+		itemID: "ITEM_42",
+	}
+}
+
+func testIssueSetConfig() *config.Config {
+	return &config.Config{
+		Project:      config.Project{Owner: "testowner", Number: 1},
+		Repositories: []string{"testowner/testrepo"},
+		Fields: map[string]config.Field{
+			"status": {Field: "Status", Values: map[string]string{"in_progress": "In progress"}},
+			"branch": {Field: "Release"},
+		},
+	}
+}
+
+func newTestIssueCmd() (*cobra.Command, *bytes.Buffer) {
+	cmd := &cobra.Command{}
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	return cmd, buf
+}
+
+func TestRunIssueSetWithDeps_SetsTextFieldByName(t *testing.T) {
+	// ARRANGE
+	mock := newMockIssueSetClient()
+	cmd, buf := newTestIssueCmd()
+
+	// ACT
+	err := runIssueSetWithDeps(cmd, "42", "release", "v1.2.0", &issueSetOptions{}, testIssueSetConfig(), mock)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mock.setCalls) != 1 {
+		t.Fatalf("expected 1 set call, got %d", len(mock.setCalls))
+	}
+	call := mock.setCalls[0]
+	if call.itemID != "ITEM_42" || call.fieldID != "Release" || call.value != "v1.2.0" {
+		t.Errorf("unexpected set call: %+v", call)
+	}
+	if mock.itemLookups[0] != "testowner/testrepo" {
+		t.Errorf("expected default repository lookup, got %v", mock.itemLookups)
+	}
+	if !strings.Contains(buf.String(), `Set Release to "v1.2.0" on #42`) {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestRunIssueSetWithDeps_ResolvesSingleSelectAlias(t *testing.T) {
+	// ARRANGE
+	mock := newMockIssueSetClient()
+	cmd, _ := newTestIssueCmd()
+
+	// ACT
+	err := runIssueSetWithDeps(cmd, "42", "status", "in_progress", &issueSetOptions{}, testIssueSetConfig(), mock)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mock.setCalls) != 1 || mock.setCalls[0].fieldID != "Status" || mock.setCalls[0].value != "In progress" {
+		t.Errorf("expected Status set to 'In progress', got %+v", mock.setCalls)
+	}
+}
+
+func TestRunIssueSetWithDeps_Clear(t *testing.T) {
+	// ARRANGE
+	mock := newMockIssueSetClient()
+	cmd, buf := newTestIssueCmd()
+
+	// ACT
+	err := runIssueSetWithDeps(cmd, "42", "Release", "", &issueSetOptions{}, testIssueSetConfig(), mock)
+
+	// ASSERT: an empty value goes to the client, which clears the field
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mock.setCalls) != 1 || mock.setCalls[0].fieldID != "Release" || mock.setCalls[0].value != "" {
+		t.Errorf("expected Release cleared, got %+v", mock.setCalls)
+	}
+	if !strings.Contains(buf.String(), "Cleared Release on #42") {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestRunIssueSetWithDeps_BadSingleSelectValue(t *testing.T) {
+	// ARRANGE
+	mock := newMockIssueSetClient()
+	cmd, _ := newTestIssueCmd()
+
+	// ACT
+	err := runIssueSetWithDeps(cmd, "42", "status", "Shipped", &issueSetOptions{}, testIssueSetConfig(), mock)
+
+	// ASSERT
+	if err == nil {
+		t.Fatal("expected error for unknown option")
+	}
+	if !strings.Contains(err.Error(), `invalid value "Shipped" for field "Status"`) ||
+		!strings.Contains(err.Error(), "Valid options: Backlog, In progress") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(mock.setCalls) != 0 {
+		t.Errorf("expected no set calls, got %+v", mock.setCalls)
+	}
+}
+
+func TestRunIssueSetWithDeps_IssueNotInProject(t *testing.T) {
+	// ARRANGE
+	mock := newMockIssueSetClient()
+	mock.getItemErr = errors.New("issue #42 is not in the project")
+	cmd, _ := newTestIssueCmd()
+
+	// ACT
+	err := runIssueSetWithDeps(cmd, "42", "release", "v1.2.0", &issueSetOptions{}, testIssueSetConfig(), mock)
+
+	// ASSERT
+	if err == nil || !strings.Contains(err.Error(), "not in the project") {
+		t.Errorf("expected not-in-project error, got: %v", err)
+	}
+	if len(mock.setCalls) != 0 {
+		t.Errorf("expected no set calls, got %+v", mock.setCalls)
+	}
+}
+
+func TestRunIssueSetWithDeps_UnknownField(t *testing.T) {
+	// ARRANGE
+	mock := newMockIssueSetClient()
+	cmd, _ := newTestIssueCmd()
+
+	// ACT
+	err := runIssueSetWithDeps(cmd, "42", "Sprint", "1", &issueSetOptions{}, testIssueSetConfig(), mock)
+
+	// ASSERT
+	if err == nil || !strings.Contains(err.Error(), `field "Sprint" not found in project`) {
+		t.Errorf("expected unknown field error, got: %v", err)
+	}
+}
+
+// mockIssueCommentsClient implements issueCommentsClient for testing
+type mockIssueCommentsClient struct {
+	issue    *api.Issue
+	comments []api.Comment
+	getErr   error
+
+	// Captured calls
+	commentLookups []string
+	added          []api.Comment // ID holds the issue ID the comment was posted to
+}
+
+func (m *mockIssueCommentsClient) GetIssueByNumber(owner, repo string, number int) (*api.Issue, error) {
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+	return m.issue, nil
+}
+
+func (m *mockIssueCommentsClient) GetIssueComments(owner, repo string, number int) ([]api.Comment, error) {
+	m.commentLookups = append(m.commentLookups, fmt.Sprintf("%s/%s#%d", owner, repo, number))
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+	return m.comments, nil
+}
+
+func (m *mockIssueCommentsClient) AddIssueComment(issueID, body string) (*api.Comment, error) {
+	m.added = append(m.added, api.Comment{ID: issueID, Body: body})
+	return &api.Comment{DatabaseId: 987}, nil
+}
+
+func newMockIssueCommentsClient() *mockIssueCommentsClient {
+	return &mockIssueCommentsClient{
+		issue: &api.Issue{ID: "ISSUE_42", Number: 42, URL: "https://github.com/testowner/testrepo/issues/42"},
+		comments: []api.Comment{
+			{Author: "alice", CreatedAt: "2026-01-01T10:00:00Z", Body: "First look"},
+			{Author: "bob", CreatedAt: "2026-01-02T10:00:00Z", Body: "Repro steps attached"},
+			{Author: "alice", CreatedAt: "2026-01-03T10:00:00Z", Body: "Fixed in #50"},
+		},
+	}
+}
+
+func TestRunIssueCommentsListWithDeps_PrintsComments(t *testing.T) {
+	// ARRANGE
+	mock := newMockIssueCommentsClient()
+	cmd, buf := newTestIssueCmd()
+
+	// ACT
+	err := runIssueCommentsListWithDeps(cmd, "42", &issueCommentsOptions{}, testIssueSetConfig(), mock)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "@alice commented on 2026-01-01T10:00:00Z:\nFirst look\n\n" +
+		"@bob commented on 2026-01-02T10:00:00Z:\nRepro steps attached\n\n" +
+		"@alice commented on 2026-01-03T10:00:00Z:\nFixed in #50\n"
+	if buf.String() != want {
+		t.Errorf("unexpected output:\n%s", buf.String())
+	}
+	if len(mock.commentLookups) != 1 || mock.commentLookups[0] != "testowner/testrepo#42" {
+		t.Errorf("expected lookup in the configured repository, got %v", mock.commentLookups)
+	}
+}
+
+func TestRunIssueCommentsListWithDeps_Last(t *testing.T) {
+	mock := newMockIssueCommentsClient()
+	cmd, buf := newTestIssueCmd()
+
+	err := runIssueCommentsListWithDeps(cmd, "42", &issueCommentsOptions{last: 1}, testIssueSetConfig(), mock)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(buf.String(), "First look") || !strings.Contains(buf.String(), "Fixed in #50") {
+		t.Errorf("expected only the last comment, got:\n%s", buf.String())
+	}
+}
+
+func TestRunIssueCommentsListWithDeps_IssueNotFound(t *testing.T) {
+	mock := newMockIssueCommentsClient()
+	mock.getErr = errors.New("Could not resolve to an Issue with the number of 999")
+	cmd, _ := newTestIssueCmd()
+
+	err := runIssueCommentsListWithDeps(cmd, "999", &issueCommentsOptions{}, testIssueSetConfig(), mock)
+
+	if err == nil || !strings.Contains(err.Error(), "Could not resolve") {
+		t.Errorf("expected not found error, got: %v", err)
+	}
+}
+
+func TestRunIssueCommentsAddWithDeps_PostsToResolvedIssue(t *testing.T) {
+	// ARRANGE
+	mock := newMockIssueCommentsClient()
+	cmd, buf := newTestIssueCmd()
+
+	// ACT
+	err := runIssueCommentsAddWithDeps(cmd, "42", "Deployed to staging", &issueCommentsOptions{}, testIssueSetConfig(), mock)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mock.added) != 1 || mock.added[0].ID != "ISSUE_42" || mock.added[0].Body != "Deployed to staging" {
+		t.Errorf("expected comment posted to ISSUE_42, got %+v", mock.added)
+	}
+	if !strings.Contains(buf.String(), "issues/42#issuecomment-987") {
+		t.Errorf("expected comment URL, got: %s", buf.String())
+	}
+}
+
+func TestRunIssueCommentsAddWithDeps_EmptyBody(t *testing.T) {
+	mock := newMockIssueCommentsClient()
+	cmd, _ := newTestIssueCmd()
+
+	err := runIssueCommentsAddWithDeps(cmd, "42", "  \n", &issueCommentsOptions{}, testIssueSetConfig(), mock)
+
+	if err == nil || !strings.Contains(err.Error(), "comment body cannot be empty") {
+		t.Errorf("expected empty body error, got: %v", err)
+	}
+	if len(mock.added) != 0 {
+		t.Errorf("expected nothing posted, got %+v", mock.added)
+	}
+}
+
+func TestRunIssueCommentsAddWithDeps_IssueNotFound(t *testing.T) {
+	mock := newMockIssueCommentsClient()
+	mock.getErr = errors.New("issue not found")
+	cmd, _ := newTestIssueCmd()
+
+	err := runIssueCommentsAddWithDeps(cmd, "999", "text", &issueCommentsOptions{}, testIssueSetConfig(), mock)
+
+	if err == nil || !strings.Contains(err.Error(), "failed to get issue #999") {
+		t.Errorf("expected issue lookup error, got: %v", err)
+	}
+}
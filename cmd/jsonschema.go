@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// jsonSchemaDraft is the JSON Schema dialect --schema output declares
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchemaOf describes values of t as a JSON Schema, reading property names
+// from json tags. It is generated from the output structs so the schema
+// changes whenever they do. Fields tagged omitempty are optional; every
+// other field is required and no other properties are allowed.
+func jsonSchemaOf(t reflect.Type) map[string]interface{} {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return jsonSchemaOf(t.Elem())
+	case reflect.Struct:
+		properties := make(map[string]interface{})
+		required := []string{}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			properties[name] = jsonSchemaOf(field.Type)
+			if !strings.Contains(","+opts+",", ",omitempty,") {
+				required = append(required, name)
+			}
+		}
+		return map[string]interface{}{
+			"type":                 "object",
+			"properties":           properties,
+			"required":             required,
+			"additionalProperties": false,
+		}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": jsonSchemaOf(t.Elem())}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	}
+	return map[string]interface{}{}
+}
+
+// withConst pins the string property name of an object schema to value
+func withConst(schema map[string]interface{}, name, value string) map[string]interface{} {
+	schema["properties"].(map[string]interface{})[name] = map[string]interface{}{"const": value}
+	return schema
+}
+
+// writeJSONSchema writes schema as an indented JSON Schema document
+func writeJSONSchema(w io.Writer, title string, schema map[string]interface{}) error {
+	schema["$schema"] = jsonSchemaDraft
+	schema["title"] = title
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(schema)
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJSONSchemaOf_RequiredFollowsOmitempty(t *testing.T) {
+	type sample struct {
+		Name    string   `json:"name"`
+		Count   int      `json:"count"`
+		Labels  []string `json:"labels,omitempty"`
+		Skipped string   `json:"-"`
+		hidden  bool
+	}
+
+	schema := jsonSchemaOf(reflect.TypeOf(sample{}))
+
+	properties := schema["properties"].(map[string]interface{})
+	if len(properties) != 3 {
+		t.Errorf("Expected name, count and labels, got %v", properties)
+	}
+	if !reflect.DeepEqual(schema["required"], []string{"name", "count"}) {
+		t.Errorf("Expected name and count required, got %v", schema["required"])
+	}
+	want := map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}}
+	if !reflect.DeepEqual(properties["labels"], want) {
+		t.Errorf("Expected a string array for labels, got %v", properties["labels"])
+	}
+	if properties["count"].(map[string]interface{})["type"] != "integer" {
+		t.Errorf("Expected count to be an integer, got %v", properties["count"])
+	}
+}
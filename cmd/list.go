@@ -71,6 +71,8 @@ Use filters to narrow down the results.`,
 
 	cmd.Flags().StringVarP(&opts.status, "status", "s", "", "Filter by status (e.g., backlog, in_progress, done)")
 	cmd.Flags().StringVarP(&opts.priority, "priority", "p", "", "Filter by priority (e.g., p0, p1, p2)")
+	_ = cmd.RegisterFlagCompletionFunc("status", completeFieldAliases("status"))
+	_ = cmd.RegisterFlagCompletionFunc("priority", completeFieldAliases("priority"))
 	cmd.Flags().StringVarP(&opts.assignee, "assignee", "a", "", "Filter by assignee login")
 	cmd.Flags().StringVarP(&opts.label, "label", "l", "", "Filter by label name")
 	cmd.Flags().StringVarP(&opts.search, "search", "q", "", "Search in issue title and body")
@@ -96,7 +98,7 @@ func runList(cmd *cobra.Command, opts *listOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -107,7 +109,7 @@ func runList(cmd *cobra.Command, opts *listOptions) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return runListWithDeps(cmd, opts, cfg, client)
 }
@@ -226,7 +228,7 @@ func runListWithDeps(cmd *cobra.Command, opts *listOptions, cfg *config.Config,
 			// Resolve "current" to active branch
 			parts := strings.Split(repoFilter, "/")
 			if len(parts) == 2 {
-				releaseIssues, err := client.GetOpenIssuesByLabel(parts[0], parts[1], "branch")
+				releaseIssues, err := client.GetOpenIssuesByLabel(parts[0], parts[1], cfg.GetBranchLabel())
 				if err == nil {
 					for _, issue := range releaseIssues {
 						// Support both "Branch: " and "Release: " prefixes
@@ -524,6 +526,9 @@ func outputJSON(cmd *cobra.Command, items []api.ProjectItem) error {
 		}
 
 		for _, fv := range item.FieldValues {
+			if api.IsPseudoField(fv.Field) {
+				continue
+			}
 			jsonItem.FieldValues[fv.Field] = fv.Value
 		}
 
@@ -737,6 +742,9 @@ func filterListJSONFields(items []api.ProjectItem, fields []string) []map[string
 		if fieldSet["fieldvalues"] {
 			fieldValues := make(map[string]string)
 			for _, fv := range item.FieldValues {
+				if api.IsPseudoField(fv.Field) {
+					continue
+				}
 				fieldValues[fv.Field] = fv.Value
 			}
 			filtered["fieldValues"] = fieldValues
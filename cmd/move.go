@@ -18,7 +18,7 @@ type moveOptions struct {
 	recursive bool
 	depth     int
 	dryRun    bool
-	force     bool   // bypass checkbox validation
+	force     bool   // bypass checkbox validation and status transitions
 	yes       bool   // skip confirmation
 	repo      string // repository override (owner/repo format)
 }
@@ -57,6 +57,10 @@ that are already in the configured project.
 Field values are resolved through config aliases, so you can use
 shorthand values like "in_progress" which will be mapped to "In Progress".
 
+When fields.status.transitions is configured, a status change must follow
+it: moving from a status to one not listed for it fails before any issue is
+updated. --force bypasses the check.
+
 Use --recursive to update all sub-issues as well. This will traverse
 the issue tree and apply the same changes to all descendants.
 
@@ -101,12 +105,14 @@ Examples:
 
 	cmd.Flags().StringVarP(&opts.status, "status", "s", "", "Set project status field")
 	cmd.Flags().StringVarP(&opts.priority, "priority", "p", "", "Set project priority field")
+	_ = cmd.RegisterFlagCompletionFunc("status", completeFieldAliases("status"))
+	_ = cmd.RegisterFlagCompletionFunc("priority", completeFieldAliases("priority"))
 	cmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "Set branch field (use 'current' for active branch)")
 	cmd.Flags().BoolVar(&opts.backlog, "backlog", false, "Clear branch field (return to backlog)")
 	cmd.Flags().BoolVarP(&opts.recursive, "recursive", "r", false, "Apply changes to all sub-issues recursively")
 	cmd.Flags().IntVar(&opts.depth, "depth", 10, "Maximum depth for recursive operations")
 	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show what would be changed without making changes")
-	cmd.Flags().BoolVarP(&opts.force, "force", "f", false, "Bypass checkbox validation (still requires body and branch)")
+	cmd.Flags().BoolVarP(&opts.force, "force", "f", false, "Bypass checkbox validation and status transitions (still requires body and branch)")
 	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip confirmation prompts (for --recursive and --force)")
 	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Repository for the issue (owner/repo format)")
 
@@ -144,7 +150,7 @@ func runMove(cmd *cobra.Command, args []string, opts *moveOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -154,7 +160,7 @@ func runMove(cmd *cobra.Command, args []string, opts *moveOptions) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return runMoveWithDeps(cmd, args, opts, cfg, client)
 }
@@ -171,7 +177,7 @@ func runMoveWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *
 		}
 		defaultOwner, defaultRepo = parts[0], parts[1]
 	} else if len(cfg.Repositories) > 0 {
-		parts := strings.Split(cfg.Repositories[0], "/")
+		parts := strings.Split(cfg.DefaultRepository(), "/")
 		if len(parts) == 2 {
 			defaultOwner, defaultRepo = parts[0], parts[1]
 		}
@@ -335,7 +341,7 @@ func runMoveWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *
 		clearRelease = true
 		changeDescriptions = append(changeDescriptions, "Branch -> (cleared)")
 		if opts.status == "" {
-			statusValue = cfg.ResolveFieldValue("status", "backlog")
+			statusValue = cfg.StatusBacklogValue()
 			changeDescriptions = append(changeDescriptions, fmt.Sprintf("Status -> %s", statusValue))
 		}
 	}
@@ -343,7 +349,7 @@ func runMoveWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *
 		if opts.branch == "current" {
 			firstOwner := issuesToUpdate[0].Owner
 			firstRepo := issuesToUpdate[0].Repo
-			branchIssues, err := client.GetOpenIssuesByLabel(firstOwner, firstRepo, "branch")
+			branchIssues, err := client.GetOpenIssuesByLabel(firstOwner, firstRepo, cfg.GetBranchLabel())
 			if err != nil {
 				return fmt.Errorf("failed to get branch issues: %w", err)
 			}
@@ -360,6 +366,18 @@ func runMoveWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *
 		changeDescriptions = append(changeDescriptions, fmt.Sprintf("Branch -> %s", releaseValue))
 	}
 
+	// Enforce fields.status.transitions before making any changes (all-or-nothing)
+	if statusValue != "" && !opts.force {
+		for _, info := range issuesToUpdate {
+			if info.ItemID == "" {
+				continue // Skip issues not in project
+			}
+			if err := checkStatusTransition(cfg, getFieldValueFromSlice(info.FieldValues, "Status"), statusValue); err != nil {
+				return fmt.Errorf("#%d: %w", info.Number, err)
+			}
+		}
+	}
+
 	// Validate IDPF rules before making any changes (all-or-nothing)
 	// Build validation results map for dry-run display
 	var validationErrors ValidationErrors
@@ -371,7 +389,7 @@ func runMoveWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *
 		var activeReleases []string
 		if len(issuesToUpdate) > 0 {
 			firstIssue := issuesToUpdate[0]
-			releaseIssues, err := client.GetOpenIssuesByLabel(firstIssue.Owner, firstIssue.Repo, "branch")
+			releaseIssues, err := client.GetOpenIssuesByLabel(firstIssue.Owner, firstIssue.Repo, cfg.GetBranchLabel())
 			if err == nil {
 				activeReleases = discoverActiveReleases(releaseIssues)
 			}
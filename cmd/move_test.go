@@ -635,6 +635,53 @@ func TestRunMoveWithDeps_RepoFlagOverridesConfig(t *testing.T) {
 	}
 }
 
+func TestRunMoveWithDeps_DefaultsRepo(t *testing.T) {
+	tests := []struct {
+		name     string
+		repoFlag string
+		wantRepo string
+	}{
+		{"defaults.repo picks the primary repository", "", "testowner/lib"},
+		{"--repo overrides defaults.repo", "testowner/testrepo", "testowner/testrepo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE: issue #7 exists in both repositories
+			mock := newMockMoveClient()
+			mock.project = &api.Project{ID: "proj-1", Number: 1, Title: "Test Project"}
+			for i, repo := range []string{"testrepo", "lib"} {
+				r := api.Repository{Owner: "testowner", Name: repo}
+				mock.issues["testowner/"+repo+"#7"] = &api.Issue{ID: "issue-" + repo, Number: 7, Repository: r}
+				mock.projectItems = append(mock.projectItems, api.ProjectItem{
+					ID:    fmt.Sprintf("item-%d", i),
+					Issue: &api.Issue{ID: "issue-" + repo, Number: 7, Repository: r},
+				})
+			}
+			cfg := testMoveConfig()
+			cfg.Repositories = []string{"testowner/testrepo", "testowner/lib"}
+			cfg.Defaults.Repo = "testowner/lib"
+			cmd := &cobra.Command{}
+			cmd.SetOut(new(bytes.Buffer))
+			cmd.SetErr(new(bytes.Buffer))
+
+			// ACT
+			err := runMoveWithDeps(cmd, []string{"7"}, &moveOptions{status: "in_progress", repo: tt.repoFlag}, cfg, mock)
+
+			// ASSERT
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(mock.lastIssueRefs) != 1 {
+				t.Fatalf("Expected one issue lookup, got %v", mock.lastIssueRefs)
+			}
+			if got := mock.lastIssueRefs[0].Owner + "/" + mock.lastIssueRefs[0].Repo; got != tt.wantRepo {
+				t.Errorf("Expected issue looked up in %s, got %s", tt.wantRepo, got)
+			}
+		})
+	}
+}
+
 func TestRunMoveWithDeps_InvalidRepoFlagFormat(t *testing.T) {
 	mock := newMockMoveClient()
 	cfg := testMoveConfig()
@@ -1763,6 +1810,54 @@ func TestRunMoveWithDeps_BacklogExplicitStatusOverrides(t *testing.T) {
 	}
 }
 
+func TestRunMoveWithDeps_StatusTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  string
+		force   bool
+		wantErr string
+	}{
+		{"illegal transition is rejected", "done", false, "#42: cannot move from Backlog to Done; allowed: In Progress"},
+		{"legal transition proceeds", "in_progress", false, ""},
+		{"--force bypasses transitions", "done", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			mock := setupMockWithIssue(42, "Test Issue", "item-42")
+			mock.projectItems[0].FieldValues = []api.FieldValue{{Field: "Status", Value: "Backlog"}}
+			cfg := testMoveConfig()
+			status := cfg.Fields["status"]
+			status.Transitions = map[string][]string{"backlog": {"in_progress"}}
+			cfg.Fields["status"] = status
+			cmd := &cobra.Command{}
+			cmd.SetOut(new(bytes.Buffer))
+			cmd.SetErr(new(bytes.Buffer))
+
+			// ACT
+			err := runMoveWithDeps(cmd, []string{"42"}, &moveOptions{status: tt.status, force: tt.force, yes: true}, cfg, mock)
+
+			// ASSERT
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Expected error %q, got: %v", tt.wantErr, err)
+				}
+				if len(mock.fieldUpdates) != 0 {
+					t.Errorf("Expected no field updates, got %v", mock.fieldUpdates)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(mock.fieldUpdates) != 1 || mock.fieldUpdates[0].fieldName != "Status" {
+				t.Errorf("Expected the Status field to be set, got %v", mock.fieldUpdates)
+			}
+		})
+	}
+}
+
 // ============================================================================
 // Caching Behavior Tests
 // ============================================================================
@@ -3,7 +3,10 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 
+	"github.com/rubrical-studios/gh-pmu/internal/api"
 	"github.com/rubrical-studios/gh-pmu/internal/config"
 	"github.com/rubrical-studios/gh-pmu/internal/defaults"
 	pkgversion "github.com/rubrical-studios/gh-pmu/internal/version"
@@ -21,6 +24,48 @@ func getVersion() string {
 	return pkgversion.Version
 }
 
+// projectName is the value of the global --project-name flag selecting a named
+// project
+var projectName string
+
+// bodyPatterns holds the compiled sanitize.body_patterns of the loaded config,
+// applied to every API client the command creates
+var bodyPatterns []*regexp.Regexp
+
+// autoCreateOptionFields holds the fields of the loaded config whose missing
+// single-select options are created on set, applied to every API client
+var autoCreateOptionFields []string
+
+// strictConfig is the value of the global --strict flag turning unknown config keys into errors
+var strictConfig bool
+
+// projectIDFlag is the value of the global --project-id flag pinning a project node
+var projectIDFlag string
+
+// costBudget is the value of the global --cost-budget flag capping the GraphQL
+// rate-limit cost of a command (0 = unlimited)
+var costBudget int
+
+// costMeter meters every API client the invocation creates, so --cost-budget
+// caps their combined cost rather than each client's
+var costMeter = &api.CostMeter{}
+
+// profileFlag is the value of the global --profile flag selecting a
+// .gh-pmu.<profile>.yml config file
+var profileFlag string
+
+// profileEnvVar names the environment variable used when --profile is unset
+const profileEnvVar = "GH_PMU_PROFILE"
+
+// configProfile returns the config profile to load: --profile, else
+// GH_PMU_PROFILE, else "" for the default config file
+func configProfile() string {
+	if profileFlag != "" {
+		return profileFlag
+	}
+	return os.Getenv(profileEnvVar)
+}
+
 // exemptCommands are commands that do not require terms acceptance.
 var exemptCommands = map[string]bool{
 	"init":   true,
@@ -49,9 +94,16 @@ Use 'gh pmu <command> --help' for more information about a command.`,
 		},
 	}
 
+	cmd.PersistentFlags().StringVar(&projectName, "project-name", "", "Named project from the projects section of .gh-pmu.yml")
+	cmd.PersistentFlags().BoolVar(&strictConfig, "strict", false, "Fail on unknown keys in .gh-pmu.yml instead of warning")
+	cmd.PersistentFlags().StringVar(&projectIDFlag, "project-id", "", "Project node ID to use instead of resolving owner/number")
+	cmd.PersistentFlags().StringVar(&profileFlag, "profile", "", "Load .gh-pmu.<profile>.yml instead of .gh-pmu.yml (or set GH_PMU_PROFILE)")
+	cmd.PersistentFlags().IntVar(&costBudget, "cost-budget", 0, "Abort before GraphQL requests would cost more than this many rate-limit points (0 = unlimited)")
+
 	cmd.SetVersionTemplate("{{.Use}} version {{.Version}}\nRubrical Systems (c) 2026\n")
 
 	cmd.AddCommand(newInitCommand())
+	cmd.AddCommand(newConfigCommand())
 	cmd.AddCommand(newListCommand())
 	cmd.AddCommand(newViewCommand())
 	cmd.AddCommand(newCreateCommand())
@@ -62,6 +114,7 @@ Use 'gh pmu <command> --help' for more information about a command.`,
 	cmd.AddCommand(newBoardCommand())
 	cmd.AddCommand(newSubCommand())
 	cmd.AddCommand(newFieldCommand())
+	cmd.AddCommand(newIssueCommand())
 	cmd.AddCommand(newIntakeCommand())
 	cmd.AddCommand(newTriageCommand())
 	cmd.AddCommand(newSplitCommand())
@@ -77,6 +130,58 @@ func Execute() error {
 	return NewRootCommand().Execute()
 }
 
+// loadConfig loads the configuration found from dir, honoring --profile, and
+// applies the --project-name selection, so commands operate on the chosen named
+// project.
+func loadConfig(dir string) (*config.Config, error) {
+	cfg, err := config.LoadProfileFromDirectory(dir, configProfile())
+	if err != nil {
+		return nil, err
+	}
+	if keys := cfg.UnknownKeys(); len(keys) > 0 {
+		if strictConfig {
+			return nil, fmt.Errorf("unknown keys in %s: %s", config.ConfigFileName, strings.Join(keys, ", "))
+		}
+		fmt.Fprintf(os.Stderr, "Warning: unknown keys in %s ignored: %s\n", config.ConfigFileName, strings.Join(keys, ", "))
+	}
+	if err := cfg.SelectProject(projectName); err != nil {
+		return nil, err
+	}
+	if err := cfg.ExpandRepositories(listOrgRepos(cfg)); err != nil {
+		return nil, err
+	}
+	if bodyPatterns, err = cfg.BodyPatterns(); err != nil {
+		return nil, err
+	}
+	autoCreateOptionFields = cfg.AutoCreateOptionFields()
+	return cfg, nil
+}
+
+// listOrgRepos lists an owner's repositories for owner/* entries in
+// repositories, narrowed by repository_filter
+func listOrgRepos(cfg *config.Config) config.RepoLister {
+	return func(owner string) ([]string, error) {
+		var filter api.OrgRepoFilter
+		if cfg.RepositoryFilter != nil {
+			filter.Topics = cfg.RepositoryFilter.Topics
+			filter.IncludeArchived = cfg.RepositoryFilter.IncludeArchived
+		}
+		return newAPIClient().ListOrgReposFiltered(owner, filter)
+	}
+}
+
+// newAPIClient creates the API client for a command, pinned to the
+// --project-id node and capped at --cost-budget when they are given.
+func newAPIClient() *api.Client {
+	client := api.NewClient()
+	client.SetProjectID(projectIDFlag)
+	client.SetBodyPatterns(bodyPatterns)
+	client.SetAutoCreateOptions(autoCreateOptionFields)
+	client.SetCostMeter(costMeter)
+	client.SetCostBudget(costBudget)
+	return client
+}
+
 // checkAcceptance verifies terms have been accepted before running commands.
 func checkAcceptance(cmd *cobra.Command) error {
 	// Dev/source builds skip acceptance gate — only ldflags-injected builds enforce it
@@ -101,7 +206,7 @@ func checkAcceptance(cmd *cobra.Command) error {
 		return nil
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := config.LoadProfileFromDirectory(cwd, configProfile())
 	if err != nil {
 		// No config file — not initialized yet, skip acceptance check
 		return nil
@@ -2,8 +2,18 @@ package cmd
 
 import (
 	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/rubrical-studios/gh-pmu/internal/api"
+	"github.com/rubrical-studios/gh-pmu/internal/config"
 )
 
 func TestRootCommandHelp(t *testing.T) {
@@ -94,3 +104,254 @@ func TestRootCommandVersionFormat(t *testing.T) {
 		t.Errorf("Expected second line to be 'Rubrical Systems (c) 2026', got: %q", lines[1])
 	}
 }
+
+func TestRootCommand_HasProjectNameFlag(t *testing.T) {
+	cmd := NewRootCommand()
+
+	flag := cmd.PersistentFlags().Lookup("project-name")
+	if flag == nil {
+		t.Fatal("Expected persistent --project-name flag")
+	}
+	if flag.DefValue != "" {
+		t.Errorf("Expected empty default, got %q", flag.DefValue)
+	}
+}
+
+func TestRootCommand_ProjectNameFlagReachesCommandsWithLocalProject(t *testing.T) {
+	for _, path := range [][]string{{"sub", "create"}, {"config", "init"}} {
+		cmd, _, err := NewRootCommand().Find(path)
+		if err != nil {
+			t.Fatalf("Failed to find %v: %v", path, err)
+		}
+		if local := cmd.LocalFlags().Lookup("project"); local == nil || local.Value.Type() != "int" {
+			t.Errorf("Expected %v to keep its project-number --project flag", path)
+		}
+		if cmd.InheritedFlags().Lookup("project-name") == nil {
+			t.Errorf("Expected %v to inherit --project-name", path)
+		}
+	}
+}
+
+func TestLoadConfig_ProjectFlagSelectsNamedProject(t *testing.T) {
+	cfg := testBranchConfig()
+	cfg.Projects = map[string]config.Project{
+		"ops": {Owner: "ops-org", Number: 7},
+	}
+	cleanup := setupBranchTestDir(t, cfg)
+	defer cleanup()
+
+	projectName = "ops"
+	defer func() { projectName = "" }()
+
+	loaded, err := loadConfig(".")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	mock := setupMockForBranch()
+	mock.openIssues = []api.Issue{{ID: "TRACKER_1", Number: 100, Title: "Branch: v1.0.0", State: "OPEN"}}
+	cmd, _ := newTestBranchCmd()
+
+	if err := runBranchCurrentWithDeps(cmd, &branchCurrentOptions{}, loaded, mock); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(mock.getProjectCalls) != 1 {
+		t.Fatalf("Expected 1 GetProject call, got %d", len(mock.getProjectCalls))
+	}
+	call := mock.getProjectCalls[0]
+	if call.owner != "ops-org" || call.number != 7 {
+		t.Errorf("Expected GetProject(ops-org, 7), got GetProject(%s, %d)", call.owner, call.number)
+	}
+}
+
+func TestLoadConfig_NoProjectFlagUsesDefault(t *testing.T) {
+	cfg := testBranchConfig()
+	cfg.Projects = map[string]config.Project{
+		"ops": {Owner: "ops-org", Number: 7},
+	}
+	cleanup := setupBranchTestDir(t, cfg)
+	defer cleanup()
+
+	loaded, err := loadConfig(".")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if loaded.Project.Owner != "testowner" || loaded.Project.Number != 1 {
+		t.Errorf("Expected default project testowner/1, got %s/%d", loaded.Project.Owner, loaded.Project.Number)
+	}
+}
+
+func TestLoadConfig_UnknownProjectErrors(t *testing.T) {
+	cfg := testBranchConfig()
+	cfg.Projects = map[string]config.Project{
+		"ops": {Owner: "ops-org", Number: 7},
+	}
+	cleanup := setupBranchTestDir(t, cfg)
+	defer cleanup()
+
+	projectName = "nope"
+	defer func() { projectName = "" }()
+
+	_, err := loadConfig(".")
+	if err == nil {
+		t.Fatal("Expected error for undefined project")
+	}
+	if !strings.Contains(err.Error(), "ops") {
+		t.Errorf("Expected available project names in error, got: %v", err)
+	}
+}
+
+func TestLoadConfig_StrictRejectsUnknownKeys(t *testing.T) {
+	dir := t.TempDir()
+	data := "project:\n  owner: testowner\n  number: 1\nrepositores:\n  - testowner/testrepo\n"
+	if err := os.WriteFile(filepath.Join(dir, config.ConfigFileName), []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	strictConfig = true
+	defer func() { strictConfig = false }()
+
+	_, err := loadConfig(dir)
+	if err == nil {
+		t.Fatal("Expected error for unknown key under --strict")
+	}
+	if !strings.Contains(err.Error(), "repositores (line 4)") {
+		t.Errorf("Expected unknown key in error, got: %v", err)
+	}
+}
+
+func TestLoadConfig_ProfileLoadsProfileFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, config.ConfigFileName), []byte("project:\n  owner: testowner\n  number: 1\nrepositories:\n  - testowner/app\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".gh-pmu.staging.yml"), []byte("project:\n  owner: stage-org\n  number: 9\nrepositories:\n  - stage-org/app\n"), 0644); err != nil {
+		t.Fatalf("failed to write profile config: %v", err)
+	}
+
+	profileFlag = "staging"
+	defer func() { profileFlag = "" }()
+
+	loaded, err := loadConfig(dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if loaded.Project.Owner != "stage-org" || loaded.Project.Number != 9 {
+		t.Errorf("Expected profile project stage-org/9, got %s/%d", loaded.Project.Owner, loaded.Project.Number)
+	}
+}
+
+func TestLoadConfig_ProfileFromEnv(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".gh-pmu.ci.yml"), []byte("project:\n  owner: ci-org\n  number: 3\nrepositories:\n  - ci-org/app\n"), 0644); err != nil {
+		t.Fatalf("failed to write profile config: %v", err)
+	}
+	t.Setenv(profileEnvVar, "ci")
+
+	loaded, err := loadConfig(dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if loaded.Project.Owner != "ci-org" {
+		t.Errorf("Expected profile project ci-org, got %s", loaded.Project.Owner)
+	}
+}
+
+func TestLoadConfig_MissingProfileErrors(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, config.ConfigFileName), []byte("project:\n  owner: testowner\n  number: 1\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	profileFlag = "prod"
+	defer func() { profileFlag = "" }()
+
+	_, err := loadConfig(dir)
+	if err == nil {
+		t.Fatal("Expected error for missing profile config")
+	}
+	if !strings.Contains(err.Error(), ".gh-pmu.prod.yml") {
+		t.Errorf("Expected resolved profile path in error, got: %v", err)
+	}
+}
+
+func TestNewAPIClient_CostBudgetCoversEveryClient(t *testing.T) {
+	// ARRANGE: every query costs 7 against a budget of 15
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"ghPmuCost":{"cost":7},"node":{"body":"text"}}}`))
+	}))
+	defer server.Close()
+	api.SetTestTransport(&redirectTransport{server: server})
+	api.SetTestAuthToken("test-token")
+	origBudget, origMeter := costBudget, costMeter
+	costBudget, costMeter = 15, &api.CostMeter{}
+	defer func() {
+		api.SetTestTransport(nil)
+		api.SetTestAuthToken("")
+		costBudget, costMeter = origBudget, origMeter
+	}()
+
+	// ACT: a later client, like the one listOrgRepos creates, must not start from 0
+	_, firstErr := newAPIClient().GetIssueBody("I_1")
+	_, secondErr := newAPIClient().GetIssueBody("I_2")
+	_, thirdErr := newAPIClient().GetIssueBody("I_3")
+
+	// ASSERT
+	if firstErr != nil || secondErr != nil {
+		t.Fatalf("Expected the first two queries to be sent, got %v and %v", firstErr, secondErr)
+	}
+	if !errors.Is(thirdErr, api.ErrCostBudgetExceeded) {
+		t.Errorf("Expected ErrCostBudgetExceeded from a fresh client, got: %v", thirdErr)
+	}
+	if costMeter.Spent() != 14 {
+		t.Errorf("Expected 14 on the shared meter, got %d", costMeter.Spent())
+	}
+}
+
+func TestLoadConfig_OwnerWildcardListedOncePerCacheTTL(t *testing.T) {
+	// ARRANGE: a config with acme/* and a server counting org listings
+	cfg := testBranchConfig()
+	cfg.Repositories = []string{"acme/*"}
+	cleanup := setupBranchTestDir(t, cfg)
+	defer cleanup()
+	config.SetTestRepoCacheDir(t.TempDir())
+	defer config.SetTestRepoCacheDir("")
+
+	listings := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "ListOrgRepos") {
+			listings++
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"repositoryOwner":{"repositories":{"nodes":[` +
+			`{"nameWithOwner":"acme/api","isArchived":false,"repositoryTopics":{"nodes":[]}}],` +
+			`"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`))
+	}))
+	defer server.Close()
+	api.SetTestTransport(&redirectTransport{server: server})
+	api.SetTestAuthToken("test-token")
+	defer func() {
+		api.SetTestTransport(nil)
+		api.SetTestAuthToken("")
+	}()
+
+	// ACT: two commands in a row load the config
+	first, firstErr := loadConfig(".")
+	second, secondErr := loadConfig(".")
+
+	// ASSERT
+	if firstErr != nil || secondErr != nil {
+		t.Fatalf("Expected both loads to succeed, got %v and %v", firstErr, secondErr)
+	}
+	if listings != 1 {
+		t.Errorf("Expected the second load to use the cached listing, got %d ListOrgRepos calls", listings)
+	}
+	for _, loaded := range []*config.Config{first, second} {
+		if want := []string{"acme/api"}; !reflect.DeepEqual(loaded.Repositories, want) {
+			t.Errorf("Expected %v, got %v", want, loaded.Repositories)
+		}
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"strings"
 
 	"github.com/rubrical-studios/gh-pmu/internal/api"
-	"github.com/rubrical-studios/gh-pmu/internal/config"
 	"github.com/spf13/cobra"
 )
 
@@ -81,7 +80,7 @@ func runSplit(cmd *cobra.Command, args []string, opts *splitOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -99,9 +98,9 @@ func runSplit(cmd *cobra.Command, args []string, opts *splitOptions) error {
 		}
 		owner, repo = parts[0], parts[1]
 	} else if len(cfg.Repositories) > 0 {
-		parts := strings.SplitN(cfg.Repositories[0], "/", 2)
+		parts := strings.SplitN(cfg.DefaultRepository(), "/", 2)
 		if len(parts) != 2 {
-			return fmt.Errorf("invalid repository format: %s", cfg.Repositories[0])
+			return fmt.Errorf("invalid repository format: %s", cfg.DefaultRepository())
 		}
 		owner, repo = parts[0], parts[1]
 	} else {
@@ -109,7 +108,7 @@ func runSplit(cmd *cobra.Command, args []string, opts *splitOptions) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return runSplitWithDeps(cmd, args, opts, client, owner, repo, issueNum)
 }
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/rubrical-studios/gh-pmu/internal/api"
-	"github.com/rubrical-studios/gh-pmu/internal/config"
 	"github.com/rubrical-studios/gh-pmu/internal/ui"
 	"github.com/spf13/cobra"
 )
@@ -71,7 +70,7 @@ func runSubAdd(cmd *cobra.Command, args []string, opts *subAddOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -101,7 +100,7 @@ func runSubAdd(cmd *cobra.Command, args []string, opts *subAddOptions) error {
 		}
 		defaultOwner, defaultRepo = parts[0], parts[1]
 	} else if len(cfg.Repositories) > 0 {
-		parts := strings.Split(cfg.Repositories[0], "/")
+		parts := strings.Split(cfg.DefaultRepository(), "/")
 		if len(parts) == 2 {
 			defaultOwner, defaultRepo = parts[0], parts[1]
 		}
@@ -125,7 +124,7 @@ func runSubAdd(cmd *cobra.Command, args []string, opts *subAddOptions) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	// Validate parent issue exists
 	parentIssue, err := client.GetIssue(parentOwner, parentRepo, parentNumber)
@@ -236,7 +235,7 @@ func runSubCreate(cmd *cobra.Command, opts *subCreateOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -270,9 +269,9 @@ func runSubCreate(cmd *cobra.Command, opts *subCreateOptions) error {
 		if len(cfg.Repositories) == 0 {
 			return fmt.Errorf("no repository specified and none configured")
 		}
-		parts := strings.Split(cfg.Repositories[0], "/")
+		parts := strings.Split(cfg.DefaultRepository(), "/")
 		if len(parts) != 2 {
-			return fmt.Errorf("invalid repository format in config: %s", cfg.Repositories[0])
+			return fmt.Errorf("invalid repository format in config: %s", cfg.DefaultRepository())
 		}
 		parentOwner = parts[0]
 		parentRepo = parts[1]
@@ -295,7 +294,7 @@ func runSubCreate(cmd *cobra.Command, opts *subCreateOptions) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	// Get parent issue to validate and optionally inherit from
 	parentIssue, err := client.GetIssue(parentOwner, parentRepo, parentNumber)
@@ -467,7 +466,7 @@ func runSubList(cmd *cobra.Command, args []string, opts *subListOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -491,7 +490,7 @@ func runSubList(cmd *cobra.Command, args []string, opts *subListOptions) error {
 		}
 		defaultOwner, defaultRepo = parts[0], parts[1]
 	} else if len(cfg.Repositories) > 0 {
-		parts := strings.Split(cfg.Repositories[0], "/")
+		parts := strings.Split(cfg.DefaultRepository(), "/")
 		if len(parts) == 2 {
 			defaultOwner, defaultRepo = parts[0], parts[1]
 		}
@@ -507,7 +506,7 @@ func runSubList(cmd *cobra.Command, args []string, opts *subListOptions) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	// Get the issue to validate it exists
 	issue, err := client.GetIssue(issueOwner, issueRepo, issueNumber)
@@ -940,7 +939,7 @@ func runSubRemove(cmd *cobra.Command, args []string, opts *subRemoveOptions) err
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -964,7 +963,7 @@ func runSubRemove(cmd *cobra.Command, args []string, opts *subRemoveOptions) err
 		}
 		defaultOwner, defaultRepo = parts[0], parts[1]
 	} else if len(cfg.Repositories) > 0 {
-		parts := strings.Split(cfg.Repositories[0], "/")
+		parts := strings.Split(cfg.DefaultRepository(), "/")
 		if len(parts) == 2 {
 			defaultOwner, defaultRepo = parts[0], parts[1]
 		}
@@ -979,7 +978,7 @@ func runSubRemove(cmd *cobra.Command, args []string, opts *subRemoveOptions) err
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	// Validate parent issue exists
 	parentIssue, err := client.GetIssue(parentOwner, parentRepo, parentNumber)
@@ -1017,19 +1016,43 @@ func runSubRemove(cmd *cobra.Command, args []string, opts *subRemoveOptions) err
 		})
 	}
 
+	// Fetch the child issues in batches, one lookup per repository
+	childIssues := make(map[childRef]*api.Issue)
+	fetchErrs := make(map[string]error)
+	numbersByRepo := make(map[string][]int)
+	for _, child := range children {
+		key := child.owner + "/" + child.repo
+		numbersByRepo[key] = append(numbersByRepo[key], child.number)
+	}
+	for key, numbers := range numbersByRepo {
+		owner, repo, _ := strings.Cut(key, "/")
+		issues, err := client.GetIssues(owner, repo, numbers)
+		if err != nil {
+			fetchErrs[key] = err
+			continue
+		}
+		for number, issue := range issues {
+			childIssues[childRef{owner: owner, repo: repo, number: number}] = issue
+		}
+	}
+
 	// Track results for batch operations
 	var successCount, failCount int
 	var results []string
 
 	// Process each child issue
 	for _, child := range children {
-		// Get child issue
-		childIssue, err := client.GetIssue(child.owner, child.repo, child.number)
-		if err != nil {
+		if err := fetchErrs[child.owner+"/"+child.repo]; err != nil {
 			failCount++
 			results = append(results, fmt.Sprintf("✗ #%d: failed to get issue: %v", child.number, err))
 			continue
 		}
+		childIssue, ok := childIssues[child]
+		if !ok {
+			failCount++
+			results = append(results, fmt.Sprintf("✗ #%d: issue not found", child.number))
+			continue
+		}
 
 		// Remove sub-issue link
 		err = client.RemoveSubIssue(parentIssue.ID, childIssue.ID)
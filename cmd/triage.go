@@ -88,7 +88,7 @@ func runTriage(cmd *cobra.Command, args []string, opts *triageOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -98,7 +98,7 @@ func runTriage(cmd *cobra.Command, args []string, opts *triageOptions) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	return runTriageWithDeps(cmd, args, opts, cfg, client, os.Stdin)
 }
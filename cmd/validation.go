@@ -75,12 +75,8 @@ func validateStatusTransition(cfg *config.Config, ctx *issueValidationContext, t
 		return nil
 	}
 
-	// Normalize status values for comparison
-	currentStatus := strings.ToLower(ctx.CurrentStatus)
-	targetStatusLower := strings.ToLower(targetStatus)
-
 	// Rule 1: Body required for in_review/done (NOT bypassed by --force)
-	if targetStatusLower == "in_review" || targetStatusLower == "in review" || targetStatusLower == "done" {
+	if requiresCompleteBody(cfg, targetStatus) {
 		if isBodyEmpty(ctx.Body) {
 			return &ValidationError{
 				IssueNumber: ctx.Number,
@@ -91,7 +87,7 @@ func validateStatusTransition(cfg *config.Config, ctx *issueValidationContext, t
 	}
 
 	// Rule 2: All checkboxes must be checked for in_review/done (bypassed by --force)
-	if targetStatusLower == "in_review" || targetStatusLower == "in review" || targetStatusLower == "done" {
+	if requiresCompleteBody(cfg, targetStatus) {
 		unchecked := countUncheckedBoxes(ctx.Body)
 		if unchecked > 0 && !force {
 			uncheckedItems := getUncheckedItems(ctx.Body)
@@ -108,7 +104,7 @@ func validateStatusTransition(cfg *config.Config, ctx *issueValidationContext, t
 	}
 
 	// Rule 3: Release required for backlog → ready/in_progress
-	if currentStatus == "backlog" && (targetStatusLower == "ready" || targetStatusLower == "in progress" || targetStatusLower == "in_progress") {
+	if cfg.IsBacklogStatus(ctx.CurrentStatus) && (cfg.IsStatus(targetStatus, cfg.ResolveFieldValue("status", "ready")) || cfg.IsInProgressStatus(targetStatus)) {
 		// Check if release is being set or already set
 		releaseValue := targetRelease
 		if releaseValue == "" {
@@ -140,6 +136,18 @@ func validateStatusTransition(cfg *config.Config, ctx *issueValidationContext, t
 	return nil
 }
 
+// isDoneStatusValue reports whether status, an alias or option name, is a
+// done status: a configured done value, or the default one
+func isDoneStatusValue(cfg *config.Config, status string) bool {
+	return cfg.IsDoneStatus(cfg.ResolveFieldValue("status", status)) || cfg.IsStatus(status, cfg.StatusDoneValue())
+}
+
+// requiresCompleteBody reports whether moving to status needs a body with
+// every checkbox checked: in review, or done
+func requiresCompleteBody(cfg *config.Config, status string) bool {
+	return cfg.IsStatus(status, cfg.ResolveFieldValue("status", "in_review")) || isDoneStatusValue(cfg, status)
+}
+
 // isReleaseActiveInContext checks if a release name exists in the discovered active releases
 func isReleaseActiveInContext(activeReleases []string, releaseName string) bool {
 	// If no active releases discovered, allow any release (backwards compatibility)
@@ -156,7 +164,7 @@ func isReleaseActiveInContext(activeReleases []string, releaseName string) bool
 	return false
 }
 
-// discoverActiveReleases fetches active branch names from GitHub issues with "branch" label
+// discoverActiveReleases extracts active branch names from branch tracker issues
 // Returns the extracted branch names (e.g., "release/v1.2.0") from issue titles like "Branch: release/v1.2.0"
 // Supports both "Branch: " (new) and "Release: " (legacy) prefixes for backwards compatibility
 func discoverActiveReleases(issues []api.Issue) []string {
@@ -285,6 +293,29 @@ func getUncheckedItems(body string) []string {
 	return items
 }
 
+// checkStatusTransition returns an error when fields.status.transitions does
+// not allow moving from the current Status value to the target one. Issues
+// without a current status, and moves to the same status, always pass.
+func checkStatusTransition(cfg *config.Config, from, to string) error {
+	if from == "" || strings.EqualFold(from, to) {
+		return nil
+	}
+	allowed, ok := cfg.AllowedStatusTransitions(from)
+	if !ok {
+		return nil
+	}
+	for _, value := range allowed {
+		if strings.EqualFold(value, to) {
+			return nil
+		}
+	}
+	list := "none"
+	if len(allowed) > 0 {
+		list = strings.Join(allowed, ", ")
+	}
+	return fmt.Errorf("cannot move from %s to %s; allowed: %s", from, to, list)
+}
+
 // getFieldValueFromSlice extracts a field value from a slice of field values
 func getFieldValueFromSlice(fieldValues []api.FieldValue, fieldName string) string {
 	for _, fv := range fieldValues {
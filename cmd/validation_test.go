@@ -1128,3 +1128,35 @@ func TestBuildValidationContext(t *testing.T) {
 		t.Errorf("Expected 2 active releases, got %d", len(ctx.ActiveReleases))
 	}
 }
+
+func TestValidateStatusTransition_ConfiguredSemanticValues(t *testing.T) {
+	// ARRANGE: Inbox is the backlog, Doing is in progress, Shipped is done
+	cfg := &config.Config{
+		Framework: "IDPF",
+		Fields: map[string]config.Field{"status": {
+			Field:  "Status",
+			Values: map[string]string{"doing": "Doing"},
+			Semantics: &config.StatusSemantics{
+				DoneValues:       []string{"Shipped"},
+				InProgressValues: []string{"doing"},
+				BacklogValue:     "Inbox",
+			},
+		}},
+	}
+
+	// ACT & ASSERT: Inbox -> Doing needs a branch
+	ctx := &issueValidationContext{Number: 1, CurrentStatus: "Inbox", Body: "Body"}
+	if err := validateStatusTransition(cfg, ctx, "Doing", "", false); err == nil || !strings.Contains(err.Message, "No branch assignment") {
+		t.Errorf("Expected branch required for the configured backlog and in-progress values, got: %v", err)
+	}
+	// The literal backlog is no longer the backlog
+	ctx.CurrentStatus = "Backlog"
+	if err := validateStatusTransition(cfg, ctx, "Doing", "", false); err != nil {
+		t.Errorf("Expected Backlog not treated as the backlog, got: %v", err)
+	}
+	// Shipped is done, so it needs a body
+	ctx.Body = ""
+	if err := validateStatusTransition(cfg, ctx, "Shipped", "", false); err == nil || !strings.Contains(err.Message, "Empty body") {
+		t.Errorf("Expected body required for a configured done value, got: %v", err)
+	}
+}
@@ -12,7 +12,6 @@ import (
 	"sync"
 
 	"github.com/rubrical-studios/gh-pmu/internal/api"
-	"github.com/rubrical-studios/gh-pmu/internal/config"
 	"github.com/rubrical-studios/gh-pmu/internal/ui"
 	"github.com/spf13/cobra"
 )
@@ -137,7 +136,7 @@ func runView(cmd *cobra.Command, args []string, opts *viewOptions) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	cfg, err := config.LoadFromDirectory(cwd)
+	cfg, err := loadConfig(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
 	}
@@ -155,7 +154,7 @@ func runView(cmd *cobra.Command, args []string, opts *viewOptions) error {
 		}
 		defaultOwner, defaultRepo = parts[0], parts[1]
 	} else if len(cfg.Repositories) > 0 {
-		parts := strings.Split(cfg.Repositories[0], "/")
+		parts := strings.Split(cfg.DefaultRepository(), "/")
 		if len(parts) == 2 {
 			defaultOwner, defaultRepo = parts[0], parts[1]
 		}
@@ -182,7 +181,7 @@ func runView(cmd *cobra.Command, args []string, opts *viewOptions) error {
 	}
 
 	// Create API client
-	client := api.NewClient()
+	client := newAPIClient()
 
 	// Single-issue path (backward compatible, unchanged behavior)
 	if len(refs) == 1 && len(parseErrors) == 0 {
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// minWatchInterval is the shortest --interval accepted by --watch, keeping
+// long-running dashboards well inside API rate limits
+const minWatchInterval = 10 * time.Second
+
+// defaultWatchInterval is the --watch refresh interval when --interval is unset
+const defaultWatchInterval = 30 * time.Second
+
+// clearScreen clears the terminal and moves the cursor home
+const clearScreen = "\033[H\033[2J"
+
+// runWatch re-renders a view every interval until interrupted. Watching only
+// makes sense on a terminal, so it refuses redirected output. Each render
+// fetches fresh data from the API.
+func runWatch(cmd *cobra.Command, interval time.Duration, render func() error) error {
+	if interval < minWatchInterval {
+		return fmt.Errorf("--interval must be at least %s", minWatchInterval)
+	}
+	if !term.IsTerminal(int(os.Stdout.Fd())) {
+		return fmt.Errorf("--watch requires a terminal")
+	}
+
+	parent := cmd.Context()
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, stop := signal.NotifyContext(parent, os.Interrupt)
+	defer stop()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	return watchLoop(ctx, cmd.OutOrStdout(), ticker.C, render)
+}
+
+// watchLoop clears the screen and renders once, then again on every tick,
+// until ctx is cancelled (a clean stop) or a render fails
+func watchLoop(ctx context.Context, w io.Writer, ticks <-chan time.Time, render func() error) error {
+	for {
+		fmt.Fprint(w, clearScreen)
+		if err := render(); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticks:
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func TestWatchLoop_RendersOnEachTickUntilCancelled(t *testing.T) {
+	// ARRANGE: a fake ticker delivering two ticks, then cancellation
+	ctx, cancel := context.WithCancel(context.Background())
+	ticks := make(chan time.Time)
+	var buf bytes.Buffer
+	renders := 0
+
+	done := make(chan error)
+	go func() {
+		done <- watchLoop(ctx, &buf, ticks, func() error {
+			renders++
+			return nil
+		})
+	}()
+
+	// ACT
+	ticks <- time.Now()
+	ticks <- time.Now()
+	cancel()
+	err := <-done
+
+	// ASSERT: the initial render plus one per tick, each after a screen clear
+	if err != nil {
+		t.Fatalf("Expected clean stop, got: %v", err)
+	}
+	if renders != 3 {
+		t.Errorf("Expected 3 renders, got %d", renders)
+	}
+	if strings.Count(buf.String(), clearScreen) != 3 {
+		t.Errorf("Expected a screen clear before each render, got %q", buf.String())
+	}
+}
+
+func TestWatchLoop_StopsOnRenderError(t *testing.T) {
+	// ARRANGE
+	ticks := make(chan time.Time)
+
+	// ACT
+	err := watchLoop(context.Background(), &bytes.Buffer{}, ticks, func() error {
+		return errors.New("rate limited")
+	})
+
+	// ASSERT
+	if err == nil || err.Error() != "rate limited" {
+		t.Errorf("Expected render error, got: %v", err)
+	}
+}
+
+func TestRunWatch_RequiresTerminal(t *testing.T) {
+	// ARRANGE: test output is never a terminal
+	cmd := &cobra.Command{}
+
+	// ACT
+	err := runWatch(cmd, time.Minute, func() error { return nil })
+
+	// ASSERT
+	if err == nil || !strings.Contains(err.Error(), "--watch requires a terminal") {
+		t.Errorf("Expected terminal error, got: %v", err)
+	}
+}
+
+func TestRunWatch_EnforcesIntervalFloor(t *testing.T) {
+	// ACT
+	err := runWatch(&cobra.Command{}, time.Second, func() error { return nil })
+
+	// ASSERT
+	if err == nil || !strings.Contains(err.Error(), "--interval must be at least 10s") {
+		t.Errorf("Expected interval floor error, got: %v", err)
+	}
+}
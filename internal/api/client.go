@@ -3,8 +3,11 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"os"
 	"os/exec"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/cli/go-gh/v2/pkg/api"
 )
@@ -45,6 +48,22 @@ type GraphQLClient interface {
 type Client struct {
 	gql  GraphQLClient
 	opts ClientOptions
+
+	// costs meters the rate-limit cost of requests for SetCostBudget
+	costs costMeter
+
+	// repoIDs caches repository node IDs by "owner/repo"
+	repoIDsMu sync.Mutex
+	repoIDs   map[string]string
+
+	// createdOptions caches option IDs created by AutoCreateOptions, keyed by
+	// field ID and option name
+	createdOptionsMu sync.Mutex
+	createdOptions   map[string]string
+
+	// viewer caches the authenticated user's login once resolved
+	viewerMu sync.Mutex
+	viewer   string
 }
 
 // ClientOptions configures the API client
@@ -63,6 +82,19 @@ type ClientOptions struct {
 
 	// AuthToken is the authorization token (for testing)
 	AuthToken string
+
+	// MaxPages caps how many pages one paginated call fetches (default: DefaultMaxPages)
+	MaxPages int
+
+	// ProjectID, when set, makes GetProject fetch this project node directly
+	ProjectID string
+
+	// BodyPatterns are stripped from issue bodies before they are sent
+	BodyPatterns []*regexp.Regexp
+
+	// AutoCreateOptions names the single-select fields whose missing options
+	// are created when an item is set to them
+	AutoCreateOptions []string
 }
 
 // NewClient creates a new API client with default options
@@ -109,9 +141,13 @@ func NewClientWithOptions(opts ClientOptions) *Client {
 	if opts.Host != "" {
 		apiOpts.Host = opts.Host
 	}
+	// Record response request IDs so errors can cite them
+	base := http.DefaultTransport
 	if opts.Transport != nil {
-		apiOpts.Transport = opts.Transport
+		base = opts.Transport
 	}
+	costs := &costTransport{base: base}
+	apiOpts.Transport = &requestIDTransport{base: costs}
 	if opts.AuthToken != "" {
 		apiOpts.AuthToken = opts.AuthToken
 	}
@@ -125,14 +161,55 @@ func NewClientWithOptions(opts ClientOptions) *Client {
 	}
 
 	return &Client{
-		gql:  gql,
-		opts: opts,
+		gql:   &singleFlightGraphQL{GraphQLClient: &requestIDGraphQL{GraphQLClient: gql}},
+		opts:  opts,
+		costs: costs,
 	}
 }
 
 // NewClientWithGraphQL creates a Client with a custom GraphQL client (for testing)
 func NewClientWithGraphQL(gql GraphQLClient) *Client {
-	return &Client{gql: gql}
+	c := &Client{gql: gql}
+	if costs, ok := gql.(costMeter); ok {
+		c.costs = costs
+	}
+	return c
+}
+
+// SetProjectID pins GetProject to a project node ID, bypassing owner/number
+// resolution. An empty ID restores the default lookup.
+func (c *Client) SetProjectID(projectID string) {
+	c.opts.ProjectID = projectID
+}
+
+// SetBodyPatterns sets the patterns stripped from issue bodies on create and
+// update. Nil or empty patterns leave bodies untouched.
+func (c *Client) SetBodyPatterns(patterns []*regexp.Regexp) {
+	c.opts.BodyPatterns = patterns
+}
+
+// SetAutoCreateOptions makes setting a single-select field in fieldNames to a
+// value that is not yet an option create the option first, instead of failing
+func (c *Client) SetAutoCreateOptions(fieldNames []string) {
+	c.opts.AutoCreateOptions = fieldNames
+}
+
+// sanitizeBody removes every BodyPatterns match from body. If that would
+// leave nothing, it warns and returns the body unchanged rather than send an
+// empty issue.
+func (c *Client) sanitizeBody(body string) string {
+	if len(c.opts.BodyPatterns) == 0 {
+		return body
+	}
+	cleaned := body
+	for _, re := range c.opts.BodyPatterns {
+		cleaned = re.ReplaceAllString(cleaned, "")
+	}
+	if strings.TrimSpace(cleaned) == "" && strings.TrimSpace(body) != "" {
+		fmt.Fprintln(os.Stderr, "Warning: sanitize.body_patterns would empty the issue body; sending it unchanged")
+		return body
+	}
+	return cleaned
 }
 
 // joinFeatures joins feature names with commas
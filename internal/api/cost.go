@@ -0,0 +1,270 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// costAlias is the alias under which queries request rateLimit { cost },
+// chosen so it cannot collide with a field the query selects itself
+const costAlias = "ghPmuCost"
+
+// mutationCost is the cost counted for a mutation, which cannot select
+// rateLimit
+const mutationCost = 1
+
+// costMeter is implemented by anything that knows the cumulative GraphQL
+// rate-limit cost spent so far and the largest cost of a single request
+type costMeter interface {
+	Spent() int
+	Largest() int
+}
+
+// CostMeter adds up the GraphQL rate-limit cost of requests. Clients that
+// share a meter through SetCostMeter also share a budget, so one command
+// invocation can be capped however many clients it creates.
+type CostMeter struct {
+	mu      sync.Mutex
+	spent   int
+	largest int
+}
+
+// Spent returns the total cost metered so far
+func (m *CostMeter) Spent() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.spent
+}
+
+// Largest returns the highest cost of a single request metered so far, the
+// estimate for the next one
+func (m *CostMeter) Largest() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.largest
+}
+
+func (m *CostMeter) add(cost int) {
+	m.mu.Lock()
+	m.spent += cost
+	if cost > m.largest {
+		m.largest = cost
+	}
+	m.mu.Unlock()
+}
+
+// costTransport asks every GraphQL query for its rate-limit cost and adds it
+// to its meter. The cost field is stripped from responses, so callers never
+// see it. It only rewrites requests once enabled, leaving unbudgeted clients
+// alone.
+type costTransport struct {
+	base http.RoundTripper
+
+	mu      sync.Mutex
+	enabled bool
+	meter   *CostMeter
+}
+
+func (t *costTransport) enable() {
+	t.mu.Lock()
+	t.enabled = true
+	t.mu.Unlock()
+}
+
+// useMeter makes the transport add its costs to meter
+func (t *costTransport) useMeter(meter *CostMeter) {
+	t.mu.Lock()
+	t.meter = meter
+	t.mu.Unlock()
+}
+
+// currentMeter returns the meter costs are added to, creating one on first use
+func (t *costTransport) currentMeter() *CostMeter {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.meter == nil {
+		t.meter = &CostMeter{}
+	}
+	return t.meter
+}
+
+// Spent returns the total cost on the transport's meter
+func (t *costTransport) Spent() int {
+	return t.currentMeter().Spent()
+}
+
+// Largest returns the highest single request cost on the transport's meter
+func (t *costTransport) Largest() int {
+	return t.currentMeter().Largest()
+}
+
+func (t *costTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	enabled := t.enabled
+	t.mu.Unlock()
+	if !enabled || req.Body == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	body, isQuery := withCostField(body)
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	cost := mutationCost
+	if isQuery {
+		cost = 0
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		respBody, cost = stripCostField(respBody)
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+		resp.ContentLength = int64(len(respBody))
+		resp.Header.Del("Content-Length")
+	}
+
+	t.currentMeter().add(cost)
+	return resp, nil
+}
+
+// withCostField adds the aliased rateLimit { cost } selection to the top
+// level of a GraphQL query request. Mutations and bodies that are not
+// GraphQL requests come back unchanged with isQuery false.
+func withCostField(body []byte) ([]byte, bool) {
+	var request map[string]json.RawMessage
+	if err := json.Unmarshal(body, &request); err != nil {
+		return body, false
+	}
+	var query string
+	if err := json.Unmarshal(request["query"], &query); err != nil {
+		return body, false
+	}
+	// The first brace opens the top-level selection; variable definitions
+	// never contain one
+	open := strings.Index(query, "{")
+	if open < 0 || strings.HasPrefix(strings.TrimSpace(query), "mutation") {
+		return body, false
+	}
+	query = query[:open+1] + costAlias + ":rateLimit{cost}," + query[open+1:]
+
+	encoded, err := json.Marshal(query)
+	if err != nil {
+		return body, false
+	}
+	request["query"] = encoded
+	rewritten, err := json.Marshal(request)
+	if err != nil {
+		return body, false
+	}
+	return rewritten, true
+}
+
+// stripCostField removes the aliased cost from a GraphQL response and
+// returns it. A response without one (e.g. an error) costs 0.
+func stripCostField(body []byte) ([]byte, int) {
+	var response map[string]json.RawMessage
+	if err := json.Unmarshal(body, &response); err != nil {
+		return body, 0
+	}
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(response["data"], &data); err != nil || data == nil {
+		return body, 0
+	}
+	raw, ok := data[costAlias]
+	if !ok {
+		return body, 0
+	}
+	var rateLimit struct {
+		Cost int `json:"cost"`
+	}
+	_ = json.Unmarshal(raw, &rateLimit)
+	delete(data, costAlias)
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return body, rateLimit.Cost
+	}
+	response["data"] = encoded
+	rewritten, err := json.Marshal(response)
+	if err != nil {
+		return body, rateLimit.Cost
+	}
+	return rewritten, rateLimit.Cost
+}
+
+// costBudgetGraphQL refuses to send a request that would take the metered
+// cost past the budget, estimating its cost as the largest seen so far
+type costBudgetGraphQL struct {
+	GraphQLClient
+	costs  costMeter
+	budget int
+}
+
+func (g *costBudgetGraphQL) Query(name string, query interface{}, variables map[string]interface{}) error {
+	if err := g.check(); err != nil {
+		return err
+	}
+	return g.GraphQLClient.Query(name, query, variables)
+}
+
+func (g *costBudgetGraphQL) Mutate(name string, mutation interface{}, variables map[string]interface{}) error {
+	if err := g.check(); err != nil {
+		return err
+	}
+	return g.GraphQLClient.Mutate(name, mutation, variables)
+}
+
+func (g *costBudgetGraphQL) check() error {
+	spent, estimate := g.costs.Spent(), g.costs.Largest()
+	if spent >= g.budget || spent+estimate > g.budget {
+		return fmt.Errorf("%w (spent %d of %d, next request estimated at %d)", ErrCostBudgetExceeded, spent, g.budget, estimate)
+	}
+	return nil
+}
+
+// SetCostBudget caps the GraphQL rate-limit cost this client may spend.
+// A request that would take the cost past budget, judged by the largest
+// request cost seen so far, fails with ErrCostBudgetExceeded instead of
+// being sent. A budget of 0 or less is unlimited. Costs count against the
+// client's meter, which is its own unless SetCostMeter shares one.
+func (c *Client) SetCostBudget(budget int) {
+	if budget <= 0 || c.gql == nil || c.costs == nil {
+		return
+	}
+	if t, ok := c.costs.(*costTransport); ok {
+		t.enable()
+	}
+	c.gql = &costBudgetGraphQL{GraphQLClient: c.gql, costs: c.costs, budget: budget}
+}
+
+// SetCostMeter makes the client add its request costs to meter, so a budget
+// set with SetCostBudget covers every client sharing it. Clients built on a
+// custom GraphQL client keep metering through it.
+func (c *Client) SetCostMeter(meter *CostMeter) {
+	if meter == nil {
+		return
+	}
+	if t, ok := c.costs.(*costTransport); ok {
+		t.useMeter(meter)
+	}
+}
@@ -0,0 +1,230 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// costMockClient reports a fixed cost for every query it answers
+type costMockClient struct {
+	*queryMockClient
+	costPerQuery int
+	spent        int
+	largest      int
+}
+
+func (m *costMockClient) Query(name string, query interface{}, variables map[string]interface{}) error {
+	m.spent += m.costPerQuery
+	if m.costPerQuery > m.largest {
+		m.largest = m.costPerQuery
+	}
+	return m.queryMockClient.Query(name, query, variables)
+}
+
+func (m *costMockClient) Spent() int {
+	return m.spent
+}
+
+func (m *costMockClient) Largest() int {
+	return m.largest
+}
+
+// endlessItemPages answers every GetProjectItems page with one item and
+// another page to follow, counting the pages served
+func endlessItemPages(pages *int) *queryMockClient {
+	return &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			*pages++
+			items := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items")
+			nodes := items.FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+			node := newNodes.Index(0)
+			node.FieldByName("ID").SetString(fmt.Sprintf("item-%d", *pages))
+			content := node.FieldByName("Content")
+			content.FieldByName("TypeName").SetString("Issue")
+			content.FieldByName("Issue").FieldByName("Number").SetInt(int64(*pages))
+			nodes.Set(newNodes)
+			items.FieldByName("PageInfo").FieldByName("HasNextPage").SetBool(true)
+			items.FieldByName("PageInfo").FieldByName("EndCursor").SetString(fmt.Sprintf("cursor-%d", *pages))
+			return nil
+		},
+	}
+}
+
+func TestSetCostBudget_AbortsOnceBudgetIsSpent(t *testing.T) {
+	// ARRANGE
+	pages := 0
+	client := NewClientWithGraphQL(&costMockClient{queryMockClient: endlessItemPages(&pages), costPerQuery: 40})
+	client.SetCostBudget(100)
+
+	// ACT
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	// ASSERT: two pages spend 80 and a third would reach 120, so it is never
+	// sent and the budget is not overshot
+	if !errors.Is(err, ErrCostBudgetExceeded) {
+		t.Fatalf("Expected ErrCostBudgetExceeded, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "cost budget exceeded (spent 80 of 100, next request estimated at 40)") {
+		t.Errorf("Expected spent, budget and estimate in error, got: %v", err)
+	}
+	if pages != 2 {
+		t.Errorf("Expected 2 pages before aborting, got %d", pages)
+	}
+	if items != nil {
+		t.Errorf("Expected no items alongside the error, got %d", len(items))
+	}
+}
+
+func TestSetCostBudget_ZeroIsUnlimited(t *testing.T) {
+	// ARRANGE
+	pages := 0
+	mock := &costMockClient{queryMockClient: endlessItemPages(&pages), costPerQuery: 40}
+	client := NewClientWithGraphQL(mock)
+
+	// ACT
+	client.SetCostBudget(0)
+
+	// ASSERT
+	if client.gql != mock {
+		t.Errorf("Expected budget 0 to leave the client unwrapped, got %T", client.gql)
+	}
+}
+
+// roundTripFunc adapts a function to http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func jsonResponse(body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestCostTransport_RequestsAndStripsQueryCost(t *testing.T) {
+	// ARRANGE
+	var sent string
+	transport := &costTransport{base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		sent = string(body)
+		return jsonResponse(`{"data":{"ghPmuCost":{"cost":7},"viewer":{"login":"octocat"}}}`), nil
+	})}
+	transport.enable()
+	req, _ := http.NewRequest("POST", "https://api.github.com/graphql",
+		strings.NewReader(`{"query":"query GetViewer($n:Int!){viewer{login}}","variables":{"n":1}}`))
+
+	// ACT
+	resp, err := transport.RoundTrip(req)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(sent, `{ghPmuCost:rateLimit{cost},viewer{login}}`) {
+		t.Errorf("Expected cost selection in query, sent: %s", sent)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"data":{"viewer":{"login":"octocat"}}}` {
+		t.Errorf("Expected cost stripped from response, got: %s", body)
+	}
+	if transport.Spent() != 7 {
+		t.Errorf("Expected 7 spent, got %d", transport.Spent())
+	}
+}
+
+func TestCostTransport_MutationsCostOneAndPassThrough(t *testing.T) {
+	// ARRANGE
+	const request = `{"query":"mutation CloseIssue($input:CloseIssueInput!){closeIssue(input:$input){issue{id}}}"}`
+	var sent string
+	transport := &costTransport{base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		sent = string(body)
+		return jsonResponse(`{"data":{"closeIssue":{"issue":{"id":"I_1"}}}}`), nil
+	})}
+	transport.enable()
+	req, _ := http.NewRequest("POST", "https://api.github.com/graphql", strings.NewReader(request))
+
+	// ACT
+	_, err := transport.RoundTrip(req)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if sent != request {
+		t.Errorf("Expected mutation sent unchanged, got: %s", sent)
+	}
+	if transport.Spent() != mutationCost {
+		t.Errorf("Expected %d spent, got %d", mutationCost, transport.Spent())
+	}
+}
+
+func TestCostTransport_DisabledLeavesRequestsAlone(t *testing.T) {
+	// ARRANGE
+	const request = `{"query":"query GetViewer{viewer{login}}"}`
+	var sent string
+	transport := &costTransport{base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		sent = string(body)
+		return jsonResponse(`{"data":{"viewer":{"login":"octocat"}}}`), nil
+	})}
+	req, _ := http.NewRequest("POST", "https://api.github.com/graphql", strings.NewReader(request))
+
+	// ACT
+	_, err := transport.RoundTrip(req)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if sent != request || transport.Spent() != 0 {
+		t.Errorf("Expected untouched request and no cost, sent %s, spent %d", sent, transport.Spent())
+	}
+}
+
+func TestSetCostMeter_SharesBudgetAcrossClients(t *testing.T) {
+	// ARRANGE: every query costs 7 and both clients meter into one CostMeter
+	newClient := func(meter *CostMeter) *Client {
+		client := NewClientWithOptions(ClientOptions{
+			AuthToken: "test-token",
+			Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				return jsonResponse(`{"data":{"ghPmuCost":{"cost":7},"node":{"body":"text"}}}`), nil
+			}),
+		})
+		client.SetCostMeter(meter)
+		client.SetCostBudget(15)
+		return client
+	}
+	meter := &CostMeter{}
+	first, second := newClient(meter), newClient(meter)
+
+	// ACT
+	_, firstErr := first.GetIssueBody("I_1")
+	_, secondErr := second.GetIssueBody("I_2")
+	_, thirdErr := first.GetIssueBody("I_3")
+
+	// ASSERT: the second client starts from the first client's 7, not 0, and
+	// the third query would reach 21, so it is refused
+	if firstErr != nil || secondErr != nil {
+		t.Fatalf("Expected the first two queries to be sent, got %v and %v", firstErr, secondErr)
+	}
+	if !errors.Is(thirdErr, ErrCostBudgetExceeded) {
+		t.Fatalf("Expected ErrCostBudgetExceeded, got: %v", thirdErr)
+	}
+	if !strings.Contains(thirdErr.Error(), "spent 14 of 15") {
+		t.Errorf("Expected the combined cost in the error, got: %v", thirdErr)
+	}
+	if meter.Spent() != 14 {
+		t.Errorf("Expected 14 on the shared meter, got %d", meter.Spent())
+	}
+}
@@ -13,6 +13,15 @@ var (
 	ErrNotAuthenticated = errors.New("not authenticated - run 'gh auth login' first")
 	ErrNotFound         = errors.New("resource not found")
 	ErrRateLimited      = errors.New("API rate limit exceeded")
+	ErrBodyChanged      = errors.New("issue body changed since it was read")
+	ErrItemChanged      = errors.New("project item changed since it was read")
+
+	ErrPaginationStalled = errors.New("pagination did not progress (possible API issue)")
+	ErrPageLimitExceeded = errors.New("pagination exceeded page limit")
+
+	ErrCostBudgetExceeded = errors.New("cost budget exceeded")
+
+	ErrIssuesDisabled = errors.New("issues are disabled")
 )
 
 // APIError wraps GitHub API errors with additional context
@@ -30,6 +39,24 @@ func (e *APIError) Unwrap() error {
 	return e.Err
 }
 
+// PartialResultError reports a pagination that failed after at least one
+// page succeeded. Items holds everything fetched before the failure and
+// Cursor is the endCursor of the last page processed; pass it as
+// ProjectItemsFilter.After to resume from the page that failed.
+type PartialResultError[T any] struct {
+	Items  []T
+	Cursor string
+	Err    error
+}
+
+func (e *PartialResultError[T]) Error() string {
+	return fmt.Sprintf("%v (fetched %d item(s) before the failure; resume after cursor %q)", e.Err, len(e.Items), e.Cursor)
+}
+
+func (e *PartialResultError[T]) Unwrap() error {
+	return e.Err
+}
+
 // IsNotFound checks if an error indicates a resource was not found
 func IsNotFound(err error) bool {
 	if errors.Is(err, ErrNotFound) {
@@ -44,6 +71,28 @@ func IsNotFound(err error) bool {
 		strings.Contains(msg, "NOT_FOUND")
 }
 
+// isIssuesDisabled checks if an error is GitHub refusing to create an issue
+// because the repository has issues turned off. GraphQL reports "Repository
+// does not have issues enabled"; REST reports "Issues are disabled for this
+// repo".
+func isIssuesDisabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "does not have issues enabled") ||
+		strings.Contains(msg, "issues are disabled")
+}
+
+// issueCreateError wraps a failed issue creation, surfacing a repository with
+// issues disabled as ErrIssuesDisabled
+func issueCreateError(owner, repo string, err error) error {
+	if isIssuesDisabled(err) {
+		return fmt.Errorf("%w for %s/%s", ErrIssuesDisabled, owner, repo)
+	}
+	return fmt.Errorf("failed to create issue: %w", err)
+}
+
 // httpStatusCoder is implemented by errors that carry an HTTP status code.
 // go-gh's api.HTTPError satisfies this interface.
 type httpStatusCoder interface {
@@ -34,6 +34,26 @@ func TestIsNotFound_WithNil(t *testing.T) {
 	}
 }
 
+func TestIssueCreateError(t *testing.T) {
+	tests := []struct {
+		err      error
+		disabled bool
+	}{
+		{errors.New("GraphQL: Repository does not have issues enabled. (createIssue)"), true},
+		{errors.New("HTTP 410: Issues are disabled for this repo"), true},
+		{errors.New("GraphQL: Could not resolve to a Repository"), false},
+	}
+	for _, tt := range tests {
+		err := issueCreateError("owner", "repo", tt.err)
+		if got := errors.Is(err, ErrIssuesDisabled); got != tt.disabled {
+			t.Errorf("issueCreateError(%q): disabled = %v, want %v", tt.err, got, tt.disabled)
+		}
+		if !tt.disabled && !errors.Is(err, tt.err) {
+			t.Errorf("issueCreateError(%q) = %v, want the original error wrapped", tt.err, err)
+		}
+	}
+}
+
 func TestIsRateLimited_WithRateLimitError(t *testing.T) {
 	err := ErrRateLimited
 	if !IsRateLimited(err) {
@@ -0,0 +1,101 @@
+package api
+
+// Selections shared by the GraphQL query structs. shurcooL-graphql inlines an
+// embedded struct's fields into the enclosing selection, so a query embeds
+// the fragment it needs and adds its own fields alongside.
+
+// pageInfo is the pageInfo selection of a paginated connection
+type pageInfo struct {
+	HasNextPage bool
+	EndCursor   string
+}
+
+// issueSummaryFields is the minimal issue selection used by issue lists
+type issueSummaryFields struct {
+	ID     string
+	Number int
+	Title  string
+	State  string
+	URL    string `graphql:"url"`
+}
+
+// toIssue returns the selected fields as an Issue in owner/repo
+func (f issueSummaryFields) toIssue(owner, repo string) Issue {
+	return Issue{
+		ID:         f.ID,
+		Number:     f.Number,
+		Title:      f.Title,
+		State:      f.State,
+		URL:        f.URL,
+		Repository: Repository{Owner: owner, Name: repo},
+	}
+}
+
+// issueFields is the full issue selection used wherever a whole issue is read
+type issueFields struct {
+	ID          string
+	Number      int
+	Title       string
+	Body        string
+	State       string
+	StateReason string
+	URL         string `graphql:"url"`
+	Author      struct {
+		Login string
+	}
+	Assignees struct {
+		Nodes []struct {
+			Login string
+		}
+	} `graphql:"assignees(first: 10)"`
+	Labels struct {
+		Nodes []struct {
+			Name  string
+			Color string
+		}
+	} `graphql:"labels(first: 20)"`
+	Milestone struct {
+		Title string
+		DueOn string
+	}
+}
+
+// toIssue returns the selected fields as an Issue in owner/repo
+func (f issueFields) toIssue(owner, repo string) *Issue {
+	issue := &Issue{
+		ID:          f.ID,
+		Number:      f.Number,
+		Title:       f.Title,
+		Body:        f.Body,
+		State:       f.State,
+		StateReason: f.StateReason,
+		URL:         f.URL,
+		Repository:  Repository{Owner: owner, Name: repo},
+		Author:      Actor{Login: f.Author.Login},
+	}
+	for _, a := range f.Assignees.Nodes {
+		issue.Assignees = append(issue.Assignees, Actor{Login: a.Login})
+	}
+	for _, l := range f.Labels.Nodes {
+		issue.Labels = append(issue.Labels, Label{Name: l.Name, Color: l.Color})
+	}
+	if f.Milestone.Title != "" {
+		issue.Milestone = &Milestone{Title: f.Milestone.Title, DueOn: f.Milestone.DueOn}
+	}
+	return issue
+}
+
+// repositoryRef is the repository selection of content found outside a
+// repository query
+type repositoryRef struct {
+	NameWithOwner string
+}
+
+// toRepository splits the owner/name, returning ok false when it is malformed
+func (r repositoryRef) toRepository() (Repository, bool) {
+	parts := splitRepoName(r.NameWithOwner)
+	if len(parts) != 2 {
+		return Repository{}, false
+	}
+	return Repository{Owner: parts[0], Name: parts[1]}, true
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// cannedGraphQLTransport answers every request with a fixed GraphQL response
+// and records the query sent, so tests exercise the real JSON decoding
+type cannedGraphQLTransport struct {
+	response string
+	request  string
+}
+
+func (t *cannedGraphQLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, _ := io.ReadAll(req.Body)
+	t.request = string(body)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(t.response)),
+		Request:    req,
+	}, nil
+}
+
+func newCannedClient(response string) (*Client, *cannedGraphQLTransport) {
+	transport := &cannedGraphQLTransport{response: response}
+	return NewClientWithOptions(ClientOptions{Transport: transport, AuthToken: "test-token"}), transport
+}
+
+func TestFragments_GetIssueDecodesSharedFields(t *testing.T) {
+	// ARRANGE
+	client, transport := newCannedClient(`{"data":{"repository":{"issue":{
+		"id":"I_1","number":7,"title":"Fix it","body":"Details","state":"CLOSED",
+		"stateReason":"COMPLETED","url":"https://github.com/o/r/issues/7",
+		"author":{"login":"alice"},
+		"assignees":{"nodes":[{"login":"bob"}]},
+		"labels":{"nodes":[{"name":"bug","color":"d73a4a"}]},
+		"milestone":{"title":"v1","dueOn":"2025-01-31T00:00:00Z"},
+		"projectItems":{"nodes":[{"project":{"id":"P_1","title":"Roadmap","number":3}}],"pageInfo":{"hasNextPage":false,"endCursor":""}}
+	}}}}`)
+
+	// ACT
+	issue, err := client.GetIssue("o", "r", 7)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if issue.ID != "I_1" || issue.Number != 7 || issue.Title != "Fix it" || issue.Body != "Details" ||
+		issue.State != "CLOSED" || issue.StateReason != "COMPLETED" || issue.URL != "https://github.com/o/r/issues/7" {
+		t.Errorf("Unexpected core fields: %+v", issue)
+	}
+	if issue.Author.Login != "alice" || len(issue.Assignees) != 1 || issue.Assignees[0].Login != "bob" {
+		t.Errorf("Unexpected author or assignees: %+v", issue)
+	}
+	if len(issue.Labels) != 1 || issue.Labels[0] != (Label{Name: "bug", Color: "d73a4a"}) {
+		t.Errorf("Unexpected labels: %+v", issue.Labels)
+	}
+	if issue.Milestone == nil || issue.Milestone.DueOn != "2025-01-31T00:00:00Z" {
+		t.Errorf("Unexpected milestone: %+v", issue.Milestone)
+	}
+	if issue.Repository != (Repository{Owner: "o", Name: "r"}) {
+		t.Errorf("Unexpected repository: %+v", issue.Repository)
+	}
+	if len(issue.ProjectMemberships) != 1 {
+		t.Errorf("Expected 1 project membership, got %+v", issue.ProjectMemberships)
+	}
+	if !strings.Contains(transport.request, "issue(number: $number){id,number,title,body,state,stateReason,url,author{login}") {
+		t.Errorf("Expected the issue fields inlined into the query, got: %s", transport.request)
+	}
+}
+
+func TestFragments_GetRepositoryIssuesDecodesSummaryAndPageInfo(t *testing.T) {
+	client, transport := newCannedClient(`{"data":{"repository":{"issues":{
+		"nodes":[{"id":"I_1","number":1,"title":"One","state":"OPEN","url":"u1"}],
+		"pageInfo":{"hasNextPage":false,"endCursor":"c1"}
+	}}}}`)
+
+	issues, err := client.GetRepositoryIssues("o", "r", "open")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := Issue{ID: "I_1", Number: 1, Title: "One", State: "OPEN", URL: "u1", Repository: Repository{Owner: "o", Name: "r"}}
+	if len(issues) != 1 || issues[0].ID != want.ID || issues[0].URL != want.URL || issues[0].Repository != want.Repository {
+		t.Errorf("Expected %+v, got %+v", want, issues)
+	}
+	if !strings.Contains(transport.request, "nodes{id,number,title,state,url},pageInfo{hasNextPage,endCursor}") {
+		t.Errorf("Expected summary fields and pageInfo in the query, got: %s", transport.request)
+	}
+}
+
+func TestFragments_GetOpenIssuesByLabelDecodesSummaryFields(t *testing.T) {
+	client, _ := newCannedClient(`{"data":{"repository":{"issues":{
+		"nodes":[{"id":"I_2","number":2,"title":"Branch: v1","state":"OPEN","url":"u2",
+			"labels":{"nodes":[{"name":"branch"}]},"createdAt":"2025-01-01T00:00:00Z"}],
+		"pageInfo":{"hasNextPage":false,"endCursor":""}
+	}}}}`)
+
+	issues, err := client.GetOpenIssuesByLabel("o", "r", "branch")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Title != "Branch: v1" || issues[0].Labels[0].Name != "branch" || issues[0].CreatedAt.IsZero() {
+		t.Errorf("Unexpected issues: %+v", issues)
+	}
+}
+
+func TestFragments_SearchRepositoryIssuesDecodesRepositoryRef(t *testing.T) {
+	client, _ := newCannedClient(`{"data":{"search":{
+		"nodes":[{"__typename":"Issue","id":"I_3","number":3,"title":"Found","body":"b","state":"OPEN","url":"u3",
+			"author":{"login":"carol"},"assignees":{"nodes":[]},"labels":{"nodes":[{"name":"bug","color":"red"}]},
+			"milestone":{"title":""},"repository":{"nameWithOwner":"acme/app"}}],
+		"pageInfo":{"hasNextPage":false,"endCursor":""}
+	}}}`)
+
+	issues, err := client.SearchRepositoryIssues("acme", "app", SearchFilters{}, 0)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Repository != (Repository{Owner: "acme", Name: "app"}) ||
+		issues[0].Author.Login != "carol" || issues[0].Labels[0].Color != "red" || issues[0].Milestone != nil {
+		t.Errorf("Unexpected issues: %+v", issues)
+	}
+}
+
+func TestFragments_GetProjectItemsDecodesIssueAndPullRequest(t *testing.T) {
+	client, _ := newCannedClient(`{"data":{"node":{"items":{
+		"nodes":[
+			{"id":"ITEM_1","isArchived":false,"updatedAt":"2025-01-01T00:00:00Z","content":{"__typename":"Issue",
+				"id":"I_4","number":4,"title":"Issue","body":"b","state":"OPEN","url":"u4","createdAt":"2025-01-01T00:00:00Z",
+				"repository":{"nameWithOwner":"acme/app"},"milestone":{"title":"v1"},
+				"assignees":{"nodes":[{"login":"dave"}]},"labels":{"nodes":[{"name":"bug"}]}},
+				"fieldValues":{"nodes":[]}},
+			{"id":"ITEM_2","isArchived":false,"updatedAt":"2025-01-01T00:00:00Z","content":{"__typename":"PullRequest",
+				"id":"PR_5","number":5,"title":"PR","state":"MERGED","url":"u5","repository":{"nameWithOwner":"acme/lib"}},
+				"fieldValues":{"nodes":[]}}
+		],
+		"pageInfo":{"hasNextPage":false,"endCursor":""}
+	}}}}`)
+
+	items, err := client.GetProjectItems("P_1", &ProjectItemsFilter{ContentTypes: []string{ContentTypeIssue, ContentTypePullRequest}})
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(items))
+	}
+	issue := items[0].Issue
+	if issue == nil || issue.Number != 4 || issue.Body != "b" || issue.Repository != (Repository{Owner: "acme", Name: "app"}) ||
+		issue.CreatedAt.IsZero() || issue.Milestone == nil || issue.Assignees[0].Login != "dave" {
+		t.Errorf("Unexpected issue: %+v", issue)
+	}
+	pr := items[1].PullRequest
+	if pr == nil || pr.Number != 5 || pr.State != "MERGED" || pr.Repository != (Repository{Owner: "acme", Name: "lib"}) {
+		t.Errorf("Unexpected pull request: %+v", pr)
+	}
+}
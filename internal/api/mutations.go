@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	graphql "github.com/cli/shurcooL-graphql"
 
@@ -20,6 +21,8 @@ func (c *Client) CreateIssue(owner, repo, title, body string, labels []string) (
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
+	body = c.sanitizeBody(body)
+
 	// First, get the repository ID
 	repoID, err := c.GetRepositoryID(owner, repo)
 	if err != nil {
@@ -98,7 +101,7 @@ func (c *Client) CreateIssue(owner, repo, title, body string, labels []string) (
 
 	err = c.gql.Mutate("CreateIssue", &mutation, variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create issue: %w", err)
+		return nil, issueCreateError(owner, repo, err)
 	}
 
 	return &Issue{
@@ -179,6 +182,44 @@ type AddProjectV2ItemByIdInput struct {
 	ContentID graphql.ID `json:"contentId"`
 }
 
+// ArchiveProjectItem archives a project item, hiding it from project views
+// without removing it from the project
+func (c *Client) ArchiveProjectItem(projectID, itemID string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var mutation struct {
+		ArchiveProjectV2Item struct {
+			Item struct {
+				ID string
+			}
+		} `graphql:"archiveProjectV2Item(input: $input)"`
+	}
+
+	input := ArchiveProjectV2ItemInput{
+		ProjectID: graphql.ID(projectID),
+		ItemID:    graphql.ID(itemID),
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	err := c.gql.Mutate("ArchiveProjectV2Item", &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to archive project item: %w", err)
+	}
+
+	return nil
+}
+
+// ArchiveProjectV2ItemInput represents the input for archiving a project item
+type ArchiveProjectV2ItemInput struct {
+	ProjectID graphql.ID `json:"projectId"`
+	ItemID    graphql.ID `json:"itemId"`
+}
+
 // SetProjectItemField sets a field value on a project item.
 // This method fetches project fields on each call. For bulk operations,
 // use SetProjectItemFieldWithFields with pre-fetched fields for better performance.
@@ -196,8 +237,66 @@ func (c *Client) SetProjectItemField(projectID, itemID, fieldName, value string)
 	return c.SetProjectItemFieldWithFields(projectID, itemID, fieldName, value, fields)
 }
 
+// SetProjectItemFieldIfUnchanged sets a field value only if the item has not
+// changed since it was read: expectedUpdatedAt is the UpdatedAt from that
+// read. If the item was updated since, it returns ErrItemChanged without
+// writing, so the caller can re-read and retry. A zero expectedUpdatedAt is
+// a first-time write and behaves like SetProjectItemField.
+func (c *Client) SetProjectItemFieldIfUnchanged(projectID, itemID, fieldName, value string, expectedUpdatedAt time.Time) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	if !expectedUpdatedAt.IsZero() {
+		current, err := c.getProjectItemUpdatedAt(itemID)
+		if err != nil {
+			return err
+		}
+		if !current.Equal(expectedUpdatedAt) {
+			return ErrItemChanged
+		}
+	}
+
+	return c.SetProjectItemField(projectID, itemID, fieldName, value)
+}
+
+// getProjectItemUpdatedAt reads when a project item last changed
+func (c *Client) getProjectItemUpdatedAt(itemID string) (time.Time, error) {
+	var query struct {
+		Node struct {
+			ProjectV2Item struct {
+				UpdatedAt string
+			} `graphql:"... on ProjectV2Item"`
+		} `graphql:"node(id: $itemId)"`
+	}
+	variables := map[string]interface{}{
+		"itemId": graphql.ID(itemID),
+	}
+	if err := c.gql.Query("GetProjectItemUpdatedAt", &query, variables); err != nil {
+		return time.Time{}, fmt.Errorf("failed to get project item: %w", err)
+	}
+	updated, err := time.Parse(time.RFC3339, query.Node.ProjectV2Item.UpdatedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("project item not found: %s: %w", itemID, ErrNotFound)
+	}
+	return updated, nil
+}
+
+// ClearProjectItemField removes a field's value from a project item with
+// clearProjectV2ItemFieldValue, which works for every field type, unlike an
+// empty value that is invalid for single-select, date, number, and iteration
+// fields. The field is given by ID, so no field lookup is made. Clearing an
+// already-empty field succeeds.
+func (c *Client) ClearProjectItemField(projectID, itemID, fieldID string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+	return c.clearField(projectID, itemID, fieldID)
+}
+
 // SetProjectItemFieldWithFields sets a field value using pre-fetched project fields.
 // Use this method for bulk operations to avoid redundant GetProjectFields API calls.
+// An empty value clears the field (see ClearProjectItemField).
 func (c *Client) SetProjectItemFieldWithFields(projectID, itemID, fieldName, value string, fields []ProjectField) error {
 	if c.gql == nil {
 		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
@@ -215,6 +314,11 @@ func (c *Client) SetProjectItemFieldWithFields(projectID, itemID, fieldName, val
 		return fmt.Errorf("field %q not found in project", fieldName)
 	}
 
+	// Empty text is a valid text value; every other type must be cleared
+	if value == "" && field.DataType != "TEXT" {
+		return c.clearField(projectID, itemID, field.ID)
+	}
+
 	// Handle different field types
 	switch field.DataType {
 	case "SINGLE_SELECT":
@@ -241,7 +345,13 @@ func (c *Client) setSingleSelectField(projectID, itemID string, field *ProjectFi
 	}
 
 	if optionID == "" {
-		return fmt.Errorf("option %q not found for field %q", value, field.Name)
+		if !c.autoCreatesOptions(field.Name) {
+			return fmt.Errorf("option '%s' does not exist on field %s; valid options: %s", value, field.Name, field.optionNames())
+		}
+		var err error
+		if optionID, err = c.createFieldOption(field, value); err != nil {
+			return err
+		}
 	}
 
 	var mutation struct {
@@ -271,7 +381,133 @@ func (c *Client) setSingleSelectField(projectID, itemID string, field *ProjectFi
 	return nil
 }
 
+// maxTextFieldLength is the text field value length, in characters, past
+// which a set warns. Longer values are still sent.
+const maxTextFieldLength = 1024
+
+// warnLongText warns on stderr when a text field value is past
+// maxTextFieldLength, as such values usually come from a wrong argument
+func warnLongText(value string) {
+	if n := utf8.RuneCountInString(value); n > maxTextFieldLength {
+		fmt.Fprintf(os.Stderr, "Warning: text value is %d characters long (over %d)\n", n, maxTextFieldLength)
+	}
+}
+
+// optionNames lists the field's option names for error messages, or "none"
+func (f *ProjectField) optionNames() string {
+	if len(f.Options) == 0 {
+		return "none"
+	}
+	names := make([]string, len(f.Options))
+	for i, opt := range f.Options {
+		names[i] = opt.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// autoCreatesOptions reports whether missing options of the single-select
+// field fieldName are created on set (see SetAutoCreateOptions)
+func (c *Client) autoCreatesOptions(fieldName string) bool {
+	for _, name := range c.opts.AutoCreateOptions {
+		if name == fieldName {
+			return true
+		}
+	}
+	return false
+}
+
+// createFieldOption adds an option named name to a single-select field and
+// returns its ID. updateProjectV2Field replaces the whole option list, so the
+// existing options are sent back with their IDs, colors, and descriptions.
+// The new option is appended to field.Options and remembered on the client,
+// so later sets with the same or a stale field list reuse it.
+func (c *Client) createFieldOption(field *ProjectField, name string) (string, error) {
+	key := field.ID + "\x00" + name
+	c.createdOptionsMu.Lock()
+	optionID, ok := c.createdOptions[key]
+	c.createdOptionsMu.Unlock()
+	if ok {
+		return optionID, nil
+	}
+
+	var query struct {
+		Node struct {
+			Field struct {
+				Options []struct {
+					ID          string
+					Name        string
+					Color       string
+					Description string
+				}
+			} `graphql:"... on ProjectV2SingleSelectField"`
+		} `graphql:"node(id: $fieldId)"`
+	}
+	variables := map[string]interface{}{
+		"fieldId": graphql.ID(field.ID),
+	}
+	if err := c.gql.Query("GetFieldOptions", &query, variables); err != nil {
+		return "", fmt.Errorf("failed to get options of field %s: %w", field.Name, err)
+	}
+
+	var options []UpdateProjectV2FieldOptionInput
+	for _, opt := range query.Node.Field.Options {
+		options = append(options, UpdateProjectV2FieldOptionInput{
+			ID:          graphql.ID(opt.ID),
+			Name:        graphql.String(opt.Name),
+			Color:       graphql.String(opt.Color),
+			Description: graphql.String(opt.Description),
+		})
+	}
+	options = append(options, UpdateProjectV2FieldOptionInput{
+		Name:  graphql.String(name),
+		Color: graphql.String("GRAY"),
+	})
+
+	var mutation struct {
+		UpdateProjectV2Field struct {
+			ProjectV2Field struct {
+				Field struct {
+					Options []struct {
+						ID   string
+						Name string
+					}
+				} `graphql:"... on ProjectV2SingleSelectField"`
+			} `graphql:"projectV2Field"`
+		} `graphql:"updateProjectV2Field(input: $input)"`
+	}
+	mutateVars := map[string]interface{}{
+		"input": UpdateProjectV2FieldInput{
+			FieldID:             graphql.ID(field.ID),
+			SingleSelectOptions: options,
+		},
+	}
+	if err := c.gql.Mutate("UpdateProjectV2Field", &mutation, mutateVars); err != nil {
+		return "", fmt.Errorf("failed to create option '%s' on field %s: %w", name, field.Name, err)
+	}
+
+	for _, opt := range mutation.UpdateProjectV2Field.ProjectV2Field.Field.Options {
+		if opt.Name == name {
+			optionID = opt.ID
+			break
+		}
+	}
+	if optionID == "" {
+		return "", fmt.Errorf("option '%s' was not created on field %s", name, field.Name)
+	}
+
+	field.Options = append(field.Options, FieldOption{ID: optionID, Name: name})
+	c.createdOptionsMu.Lock()
+	if c.createdOptions == nil {
+		c.createdOptions = make(map[string]string)
+	}
+	c.createdOptions[key] = optionID
+	c.createdOptionsMu.Unlock()
+	return optionID, nil
+}
+
 func (c *Client) setTextField(projectID, itemID, fieldID, value string) error {
+	warnLongText(value)
+
 	var mutation struct {
 		UpdateProjectV2ItemFieldValue struct {
 			ClientMutationID string `graphql:"clientMutationId"`
@@ -332,34 +568,34 @@ func (c *Client) setNumberField(projectID, itemID, fieldID, value string) error
 	return nil
 }
 
-func (c *Client) setDateField(projectID, itemID, fieldID, value string) error {
-	// Handle empty value to clear the date field
-	if value == "" {
-		var mutation struct {
-			ClearProjectV2ItemFieldValue struct {
-				ClientMutationID string `graphql:"clientMutationId"`
-			} `graphql:"clearProjectV2ItemFieldValue(input: $input)"`
-		}
+// clearField removes a field value with clearProjectV2ItemFieldValue
+func (c *Client) clearField(projectID, itemID, fieldID string) error {
+	var mutation struct {
+		ClearProjectV2ItemFieldValue struct {
+			ClientMutationID string `graphql:"clientMutationId"`
+		} `graphql:"clearProjectV2ItemFieldValue(input: $input)"`
+	}
 
-		input := struct {
-			ProjectID graphql.ID `json:"projectId"`
-			ItemID    graphql.ID `json:"itemId"`
-			FieldID   graphql.ID `json:"fieldId"`
-		}{
-			ProjectID: graphql.ID(projectID),
-			ItemID:    graphql.ID(itemID),
-			FieldID:   graphql.ID(fieldID),
-		}
+	input := struct {
+		ProjectID graphql.ID `json:"projectId"`
+		ItemID    graphql.ID `json:"itemId"`
+		FieldID   graphql.ID `json:"fieldId"`
+	}{
+		ProjectID: graphql.ID(projectID),
+		ItemID:    graphql.ID(itemID),
+		FieldID:   graphql.ID(fieldID),
+	}
 
-		err := c.gql.Mutate("ClearProjectV2ItemFieldValue", &mutation, map[string]interface{}{
-			"input": input,
-		})
-		if err != nil {
-			return fmt.Errorf("failed to clear date field: %w", err)
-		}
-		return nil
+	err := c.gql.Mutate("ClearProjectV2ItemFieldValue", &mutation, map[string]interface{}{
+		"input": input,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to clear field value: %w", err)
 	}
+	return nil
+}
 
+func (c *Client) setDateField(projectID, itemID, fieldID, value string) error {
 	// Validate date format (YYYY-MM-DD)
 	_, err := time.Parse("2006-01-02", value)
 	if err != nil {
@@ -412,8 +648,22 @@ type ProjectV2FieldValue struct {
 
 // Helper methods
 
-// GetRepositoryID returns the node ID for a repository.
+// GetRepositoryID returns the node ID for a repository. IDs are cached per
+// client by owner/repo, so repeated mutations against one repository resolve
+// it once.
 func (c *Client) GetRepositoryID(owner, repo string) (string, error) {
+	if c.gql == nil {
+		return "", fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	key := owner + "/" + repo
+	c.repoIDsMu.Lock()
+	id, ok := c.repoIDs[key]
+	c.repoIDsMu.Unlock()
+	if ok {
+		return id, nil
+	}
+
 	var query struct {
 		Repository struct {
 			ID string
@@ -427,9 +677,21 @@ func (c *Client) GetRepositoryID(owner, repo string) (string, error) {
 
 	err := c.gql.Query("GetRepositoryID", &query, variables)
 	if err != nil {
+		if IsNotFound(err) {
+			return "", fmt.Errorf("repository not found: %s: %w", key, ErrNotFound)
+		}
 		return "", fmt.Errorf("failed to get repository ID: %w", err)
 	}
 
+	if query.Repository.ID != "" {
+		c.repoIDsMu.Lock()
+		if c.repoIDs == nil {
+			c.repoIDs = make(map[string]string)
+		}
+		c.repoIDs[key] = query.Repository.ID
+		c.repoIDsMu.Unlock()
+	}
+
 	return query.Repository.ID, nil
 }
 
@@ -605,6 +867,22 @@ type ProjectV2SingleSelectFieldOptionInput struct {
 	Description graphql.String `json:"description,omitempty"`
 }
 
+// UpdateProjectV2FieldInput represents the input for updating a project field
+type UpdateProjectV2FieldInput struct {
+	FieldID             graphql.ID                        `json:"fieldId"`
+	SingleSelectOptions []UpdateProjectV2FieldOptionInput `json:"singleSelectOptions"`
+}
+
+// UpdateProjectV2FieldOptionInput represents a single select option when
+// updating a field. An existing option keeps its ID so items set to it keep
+// their value.
+type UpdateProjectV2FieldOptionInput struct {
+	ID          graphql.ID     `json:"id,omitempty"`
+	Name        graphql.String `json:"name"`
+	Color       graphql.String `json:"color"`
+	Description graphql.String `json:"description"`
+}
+
 // DeleteProjectV2FieldInput represents the input for deleting a project field
 type DeleteProjectV2FieldInput struct {
 	FieldID graphql.ID `json:"fieldId"`
@@ -769,6 +1047,78 @@ func (c *Client) LinkProjectToRepository(projectID, repositoryID string) error {
 	return nil
 }
 
+// UpdateProjectV2Input represents the input for updating a project's settings.
+// Nil fields are left unchanged.
+type UpdateProjectV2Input struct {
+	ProjectID graphql.ID       `json:"projectId"`
+	Title     *graphql.String  `json:"title,omitempty"`
+	Closed    *graphql.Boolean `json:"closed,omitempty"`
+}
+
+// UpdateProjectTitle renames a project
+func (c *Client) UpdateProjectTitle(projectID, title string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+	if strings.TrimSpace(title) == "" {
+		return fmt.Errorf("project title cannot be empty")
+	}
+
+	t := graphql.String(title)
+	if err := c.updateProject(UpdateProjectV2Input{ProjectID: graphql.ID(projectID), Title: &t}); err != nil {
+		return fmt.Errorf("failed to rename project: %w", err)
+	}
+	return nil
+}
+
+// CloseProject closes a project. Closing an already-closed project is a
+// no-op and sends no mutation.
+func (c *Client) CloseProject(projectID string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Node struct {
+			ProjectV2 struct {
+				Closed bool
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $projectId)"`
+	}
+	variables := map[string]interface{}{
+		"projectId": graphql.ID(projectID),
+	}
+	if err := c.gql.Query("GetProjectClosed", &query, variables); err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	if query.Node.ProjectV2.Closed {
+		return nil
+	}
+
+	closed := graphql.Boolean(true)
+	if err := c.updateProject(UpdateProjectV2Input{ProjectID: graphql.ID(projectID), Closed: &closed}); err != nil {
+		return fmt.Errorf("failed to close project: %w", err)
+	}
+	return nil
+}
+
+// updateProject sends an updateProjectV2 mutation
+func (c *Client) updateProject(input UpdateProjectV2Input) error {
+	var mutation struct {
+		UpdateProjectV2 struct {
+			ProjectV2 struct {
+				ID string
+			}
+		} `graphql:"updateProjectV2(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return c.gql.Mutate("UpdateProjectV2", &mutation, variables)
+}
+
 // AddLabelToIssue adds a label to an issue.
 // If the label doesn't exist in the repository, it will be created automatically.
 func (c *Client) AddLabelToIssue(owner, repo, issueID, labelName string) error {
@@ -1041,6 +1391,8 @@ func (c *Client) CreateIssueWithOptions(owner, repo, title, body string, labels,
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
+	body = c.sanitizeBody(body)
+
 	// First, get the repository ID
 	repoID, err := c.GetRepositoryID(owner, repo)
 	if err != nil {
@@ -1151,7 +1503,7 @@ func (c *Client) CreateIssueWithOptions(owner, repo, title, body string, labels,
 
 	err = c.gql.Mutate("CreateIssue", &mutation, variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create issue: %w", err)
+		return nil, issueCreateError(owner, repo, err)
 	}
 
 	return &Issue{
@@ -1234,6 +1586,8 @@ func (c *Client) UpdateIssueBody(issueID, body string) error {
 		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
+	body = c.sanitizeBody(body)
+
 	var mutation struct {
 		UpdateIssue struct {
 			Issue struct {
@@ -1259,6 +1613,28 @@ func (c *Client) UpdateIssueBody(issueID, body string) error {
 	return nil
 }
 
+// UpdateIssueBodyIfUnchanged updates the body of an issue only if its current
+// body still equals expectedBody, returning ErrBodyChanged otherwise so the
+// caller can re-read and retry. An empty expectedBody is a first-time write and
+// behaves like UpdateIssueBody.
+func (c *Client) UpdateIssueBodyIfUnchanged(issueID, expectedBody, newBody string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	if expectedBody != "" {
+		current, err := c.GetIssueBody(issueID)
+		if err != nil {
+			return err
+		}
+		if current != expectedBody {
+			return ErrBodyChanged
+		}
+	}
+
+	return c.UpdateIssueBody(issueID, newBody)
+}
+
 // UpdateIssueTitle updates the title of an issue
 func (c *Client) UpdateIssueTitle(issueID, title string) error {
 	if c.gql == nil {
@@ -1313,10 +1689,7 @@ func (c *Client) GetProjectItemID(projectID, issueID string) (string, error) {
 							} `graphql:"... on Issue"`
 						}
 					}
-					PageInfo struct {
-						HasNextPage bool
-						EndCursor   string
-					}
+					PageInfo pageInfo
 				} `graphql:"items(first: 100)"`
 			} `graphql:"... on ProjectV2"`
 		} `graphql:"node(id: $projectId)"`
@@ -1337,33 +1710,32 @@ func (c *Client) GetProjectItemID(projectID, issueID string) (string, error) {
 		}
 	}
 
-	return "", fmt.Errorf("issue not found in project")
+	return "", fmt.Errorf("issue not found in project: %w", ErrNotFound)
 }
 
-// GetProjectItemFieldValue returns the value of a field on a project item
-func (c *Client) GetProjectItemFieldValue(projectID, itemID, fieldName string) (string, error) {
+// GetProjectItemFieldValue returns the value of a field on a project item.
+// The field is matched by ID, since two project fields may share a name;
+// resolve the ID with GetProjectField. An unset field returns "".
+func (c *Client) GetProjectItemFieldValue(projectID, itemID, fieldID string) (string, error) {
+	value, err := c.GetProjectItemTypedFieldValue(projectID, itemID, fieldID)
+	if err != nil || value == nil {
+		return "", err
+	}
+	return value.Value, nil
+}
+
+// GetProjectItemTypedFieldValue is GetProjectItemFieldValue with the value's
+// type information kept, returning nil when the field is unset
+func (c *Client) GetProjectItemTypedFieldValue(projectID, itemID, fieldID string) (*FieldValue, error) {
 	if c.gql == nil {
-		return "", fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
 	var query struct {
 		Node struct {
 			ProjectV2Item struct {
 				FieldValues struct {
-					Nodes []struct {
-						ProjectV2ItemFieldTextValue struct {
-							Text  string
-							Field struct {
-								Name string
-							} `graphql:"field"`
-						} `graphql:"... on ProjectV2ItemFieldTextValue"`
-						ProjectV2ItemFieldSingleSelectValue struct {
-							Name  string
-							Field struct {
-								Name string
-							} `graphql:"field"`
-						} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
-					}
+					Nodes []fieldValueNode
 				} `graphql:"fieldValues(first: 20)"`
 			} `graphql:"... on ProjectV2Item"`
 		} `graphql:"node(id: $itemId)"`
@@ -1375,19 +1747,19 @@ func (c *Client) GetProjectItemFieldValue(projectID, itemID, fieldName string) (
 
 	err := c.gql.Query("GetProjectItemFieldValue", &query, variables)
 	if err != nil {
-		return "", fmt.Errorf("failed to get field value: %w", err)
+		return nil, fmt.Errorf("failed to get field value: %w", err)
 	}
 
 	for _, fv := range query.Node.ProjectV2Item.FieldValues.Nodes {
-		if fv.ProjectV2ItemFieldTextValue.Field.Name == fieldName {
-			return fv.ProjectV2ItemFieldTextValue.Text, nil
+		if fv.fieldID() != fieldID {
+			continue
 		}
-		if fv.ProjectV2ItemFieldSingleSelectValue.Field.Name == fieldName {
-			return fv.ProjectV2ItemFieldSingleSelectValue.Name, nil
+		if value, ok := fv.decode(); ok {
+			return &value, nil
 		}
 	}
 
-	return "", nil
+	return nil, nil
 }
 
 // WriteFile writes content to a file path
@@ -1395,6 +1767,16 @@ func (c *Client) WriteFile(path, content string) error {
 	return os.WriteFile(path, []byte(content), 0644)
 }
 
+// ReadFile reads a file. A missing file returns an error wrapping
+// os.ErrNotExist.
+func (c *Client) ReadFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 // MkdirAll creates a directory and all parents
 func (c *Client) MkdirAll(path string) error {
 	return os.MkdirAll(path, 0755)
@@ -1413,7 +1795,62 @@ func (c *Client) GitAdd(paths ...string) error {
 
 // GitTag creates an annotated git tag
 func (c *Client) GitTag(tag, message string) error {
-	cmd := exec.Command("git", "tag", "-a", tag, "-m", message)
+	return runGitTag(gitTagArgs(tag, message, false))
+}
+
+// GitTagSigned creates a GPG-signed annotated git tag. Git's error output,
+// such as a missing signing key, is included in the returned error.
+func (c *Client) GitTagSigned(tag, message string) error {
+	return runGitTag(gitTagArgs(tag, message, true))
+}
+
+// gitTagArgs builds the git arguments for an annotated tag, signed with -s
+func gitTagArgs(tag, message string, signed bool) []string {
+	mode := "-a"
+	if signed {
+		mode = "-s"
+	}
+	return []string{"tag", mode, tag, "-m", message}
+}
+
+// GitTagDate returns the date of a git tag: the tagger date for an annotated
+// tag, the commit date for a lightweight one. An unknown tag is an error.
+func (c *Client) GitTagDate(tag string) (time.Time, error) {
+	cmd := exec.Command("git", "for-each-ref", "--format=%(creatordate:iso-strict)", "refs/tags/"+tag)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("git for-each-ref failed: %s", strings.TrimSpace(string(output)))
+	}
+	date := strings.TrimSpace(string(output))
+	if date == "" {
+		return time.Time{}, fmt.Errorf("tag not found: %s", tag)
+	}
+	return time.Parse(time.RFC3339, date)
+}
+
+// GitIsClean reports whether the git worktree has no uncommitted changes or
+// untracked files
+func (c *Client) GitIsClean() (bool, error) {
+	cmd := exec.Command("git", "status", "--porcelain")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return false, fmt.Errorf("git status failed: %s", strings.TrimSpace(string(output)))
+	}
+	return strings.TrimSpace(string(output)) == "", nil
+}
+
+// GitListTags returns the names of the local git tags
+func (c *Client) GitListTags() ([]string, error) {
+	cmd := exec.Command("git", "tag", "--list")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("git tag --list failed: %s", strings.TrimSpace(string(output)))
+	}
+	return strings.Fields(string(output)), nil
+}
+
+func runGitTag(args []string) error {
+	cmd := exec.Command("git", args...)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("git tag failed: %s", strings.TrimSpace(string(output)))
@@ -1441,12 +1878,109 @@ func (c *Client) GitCheckoutNewBranch(branch string) error {
 	return nil
 }
 
-// GetAuthenticatedUser returns the login of the currently authenticated user
+// GitCurrentBranch returns the name of the checked-out git branch
+func (c *Client) GitCurrentBranch() (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git rev-parse failed: %s", strings.TrimSpace(string(output)))
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// GitDeleteBranch deletes a merged local git branch. A branch that does not
+// exist returns an error wrapping ErrNotFound.
+func (c *Client) GitDeleteBranch(name string) error {
+	if err := exec.Command("git", "show-ref", "--verify", "--quiet", "refs/heads/"+name).Run(); err != nil {
+		return fmt.Errorf("git branch %s: %w", name, ErrNotFound)
+	}
+	cmd := exec.Command("git", gitDeleteBranchArgs(name)...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git branch -d failed: %s", strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// GitDeleteRemoteBranch deletes a branch from origin. A branch origin does
+// not have returns an error wrapping ErrNotFound.
+func (c *Client) GitDeleteRemoteBranch(name string) error {
+	cmd := exec.Command("git", gitDeleteRemoteBranchArgs(name)...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		msg := strings.TrimSpace(string(output))
+		if strings.Contains(msg, "remote ref does not exist") {
+			return fmt.Errorf("remote branch origin/%s: %w", name, ErrNotFound)
+		}
+		return fmt.Errorf("git push --delete failed: %s", msg)
+	}
+	return nil
+}
+
+// GitDeleteTag deletes a local git tag. A tag that does not exist returns an
+// error wrapping ErrNotFound.
+func (c *Client) GitDeleteTag(tag string) error {
+	if err := exec.Command("git", "show-ref", "--verify", "--quiet", "refs/tags/"+tag).Run(); err != nil {
+		return fmt.Errorf("git tag %s: %w", tag, ErrNotFound)
+	}
+	cmd := exec.Command("git", gitDeleteTagArgs(tag)...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git tag -d failed: %s", strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// GitDeleteRemoteTag deletes a tag from origin. A tag origin does not have
+// returns an error wrapping ErrNotFound.
+func (c *Client) GitDeleteRemoteTag(tag string) error {
+	cmd := exec.Command("git", gitDeleteRemoteTagArgs(tag)...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		msg := strings.TrimSpace(string(output))
+		if strings.Contains(msg, "remote ref does not exist") {
+			return fmt.Errorf("remote tag %s: %w", tag, ErrNotFound)
+		}
+		return fmt.Errorf("git push --delete failed: %s", msg)
+	}
+	return nil
+}
+
+// gitDeleteBranchArgs builds the git arguments deleting a local branch. -d
+// refuses to delete a branch that is not fully merged.
+func gitDeleteBranchArgs(name string) []string {
+	return []string{"branch", "-d", name}
+}
+
+// gitDeleteRemoteBranchArgs builds the git arguments deleting a branch on origin
+func gitDeleteRemoteBranchArgs(name string) []string {
+	return []string{"push", "origin", "--delete", name}
+}
+
+// gitDeleteTagArgs builds the git arguments deleting a local tag
+func gitDeleteTagArgs(tag string) []string {
+	return []string{"tag", "-d", tag}
+}
+
+// gitDeleteRemoteTagArgs builds the git arguments deleting a tag on origin.
+// The full ref keeps a branch of the same name from matching.
+func gitDeleteRemoteTagArgs(tag string) []string {
+	return []string{"push", "origin", "--delete", "refs/tags/" + tag}
+}
+
+// GetAuthenticatedUser returns the login of the currently authenticated user.
+// The login is cached, so repeated calls cost one query.
 func (c *Client) GetAuthenticatedUser() (string, error) {
 	if c.gql == nil {
 		return "", fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
+	c.viewerMu.Lock()
+	defer c.viewerMu.Unlock()
+	if c.viewer != "" {
+		return c.viewer, nil
+	}
+
 	var query struct {
 		Viewer struct {
 			Login string
@@ -1458,7 +1992,24 @@ func (c *Client) GetAuthenticatedUser() (string, error) {
 		return "", fmt.Errorf("failed to get authenticated user: %w", err)
 	}
 
-	return query.Viewer.Login, nil
+	c.viewer = query.Viewer.Login
+	return c.viewer, nil
+}
+
+// ResolveAssignee returns the login an assignee argument refers to: @me is
+// the authenticated user, and any other login is returned unchanged.
+func (c *Client) ResolveAssignee(login string) (string, error) {
+	if login == "" {
+		return "", fmt.Errorf("assignee required")
+	}
+	if login != "@me" {
+		return login, nil
+	}
+	user, err := c.GetAuthenticatedUser()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve @me: %w", err)
+	}
+	return user, nil
 }
 
 // LabelExists checks if a label exists in a repository
@@ -1740,13 +2291,17 @@ func (c *Client) BatchUpdateProjectItemFields(projectID string, updates []FieldU
 					ItemID:    update.ItemID,
 					FieldName: update.FieldName,
 					Success:   false,
-					Error:     fmt.Sprintf("option %q not found for field %q", update.Value, update.FieldName),
+					Error:     fmt.Sprintf("option %q not found for field %q; valid options: %s", update.Value, update.FieldName, field.optionNames()),
 				})
 				continue
 			}
 			update.optionID = optionID
 		}
 
+		if field.DataType == "TEXT" {
+			warnLongText(update.Value)
+		}
+
 		// Validate number fields
 		if field.DataType == "NUMBER" {
 			if _, err := strconv.ParseFloat(update.Value, 64); err != nil {
@@ -6,7 +6,11 @@ import (
 	"math"
 	"os"
 	"os/exec"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	graphql "github.com/cli/shurcooL-graphql"
 )
@@ -20,12 +24,18 @@ func safeGraphQLInt(n int) (graphql.Int, error) {
 	return graphql.Int(n), nil
 }
 
-// GetProject fetches a project by owner and number
+// GetProject fetches a project by owner and number. When the client has a
+// project ID set (see SetProjectID), that node is fetched instead and owner
+// and number are ignored.
 func (c *Client) GetProject(owner string, number int) (*Project, error) {
 	if c.gql == nil {
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
+	if c.opts.ProjectID != "" {
+		return c.GetProjectByID(c.opts.ProjectID)
+	}
+
 	// First try as user project
 	project, err := c.getUserProject(owner, number)
 	if err == nil {
@@ -41,6 +51,67 @@ func (c *Client) GetProject(owner string, number int) (*Project, error) {
 	return project, nil
 }
 
+// GetProjectByID fetches a project by its node ID, skipping the user and
+// organization lookups GetProject performs
+func (c *Client) GetProjectByID(projectID string) (*Project, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Node struct {
+			ProjectV2 struct {
+				ID     string
+				Number int
+				Title  string
+				URL    string `graphql:"url"`
+				Closed bool
+				Owner  struct {
+					TypeName string `graphql:"__typename"`
+					User     struct {
+						Login string
+					} `graphql:"... on User"`
+					Organization struct {
+						Login string
+					} `graphql:"... on Organization"`
+				}
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $id)"`
+	}
+
+	variables := map[string]interface{}{
+		"id": graphql.ID(projectID),
+	}
+
+	err := c.gql.Query("GetProjectByID", &query, variables)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, fmt.Errorf("project not found: %s: %w", projectID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get project %s: %w", projectID, err)
+	}
+
+	// A node that is not a project decodes to an empty ProjectV2
+	p := query.Node.ProjectV2
+	if p.ID == "" {
+		return nil, fmt.Errorf("project not found: %s: %w", projectID, ErrNotFound)
+	}
+
+	owner := ProjectOwner{Type: p.Owner.TypeName, Login: p.Owner.User.Login}
+	if p.Owner.TypeName == "Organization" {
+		owner.Login = p.Owner.Organization.Login
+	}
+
+	return &Project{
+		ID:     p.ID,
+		Number: p.Number,
+		Title:  p.Title,
+		URL:    p.URL,
+		Closed: p.Closed,
+		Owner:  owner,
+	}, nil
+}
+
 func (c *Client) getUserProject(owner string, number int) (*Project, error) {
 	var query struct {
 		User struct {
@@ -132,6 +203,7 @@ func (c *Client) GetProjectFields(projectID string) ([]ProjectField, error) {
 
 	var allFields []ProjectField
 	var cursor *string
+	guard := c.newPageGuard()
 
 	for {
 		fields, pInfo, err := c.getProjectFieldsPage(projectID, cursor)
@@ -141,15 +213,44 @@ func (c *Client) GetProjectFields(projectID string) ([]ProjectField, error) {
 
 		allFields = append(allFields, fields...)
 
-		if !pInfo.HasNextPage {
+		next, err := guard.next(pInfo)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
 			break
 		}
-		cursor = &pInfo.EndCursor
+		cursor = next
 	}
 
 	return allFields, nil
 }
 
+// GetProjectField returns the project field named fieldName. Projects V2
+// allows two fields to share a name, so a name matching more than one field
+// is an error rather than a guess.
+func (c *Client) GetProjectField(projectID, fieldName string) (*ProjectField, error) {
+	fields, err := c.GetProjectFields(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var match *ProjectField
+	for i := range fields {
+		if fields[i].Name != fieldName {
+			continue
+		}
+		if match != nil {
+			return nil, fmt.Errorf("field name %q is ambiguous: project has fields %s and %s", fieldName, match.ID, fields[i].ID)
+		}
+		match = &fields[i]
+	}
+	if match == nil {
+		return nil, fmt.Errorf("field %q not found in project: %w", fieldName, ErrNotFound)
+	}
+	return match, nil
+}
+
 // getProjectFieldsPage fetches a single page of project fields
 func (c *Client) getProjectFieldsPage(projectID string, cursor *string) ([]ProjectField, pageInfo, error) {
 	var query struct {
@@ -170,15 +271,14 @@ func (c *Client) getProjectFieldsPage(projectID string, cursor *string) ([]Proje
 							Name     string
 							DataType string
 							Options  []struct {
-								ID   string
-								Name string
+								ID          string
+								Name        string
+								Color       string
+								Description string
 							}
 						} `graphql:"... on ProjectV2SingleSelectField"`
 					}
-					PageInfo struct {
-						HasNextPage bool
-						EndCursor   string
-					}
+					PageInfo pageInfo
 				} `graphql:"fields(first: 100, after: $cursor)"`
 			} `graphql:"... on ProjectV2"`
 		} `graphql:"node(id: $projectId)"`
@@ -208,8 +308,10 @@ func (c *Client) getProjectFieldsPage(projectID string, cursor *string) ([]Proje
 			field.DataType = node.ProjectV2SingleSelectField.DataType
 			for _, opt := range node.ProjectV2SingleSelectField.Options {
 				field.Options = append(field.Options, FieldOption{
-					ID:   opt.ID,
-					Name: opt.Name,
+					ID:          opt.ID,
+					Name:        opt.Name,
+					Color:       opt.Color,
+					Description: opt.Description,
 				})
 			}
 		case "ProjectV2Field":
@@ -224,10 +326,7 @@ func (c *Client) getProjectFieldsPage(projectID string, cursor *string) ([]Proje
 		fields = append(fields, field)
 	}
 
-	return fields, pageInfo{
-		HasNextPage: query.Node.ProjectV2.Fields.PageInfo.HasNextPage,
-		EndCursor:   query.Node.ProjectV2.Fields.PageInfo.EndCursor,
-	}, nil
+	return fields, query.Node.ProjectV2.Fields.PageInfo, nil
 }
 
 // GetIssue fetches an issue by repository and number
@@ -239,29 +338,8 @@ func (c *Client) GetIssue(owner, repo string, number int) (*Issue, error) {
 	var query struct {
 		Repository struct {
 			Issue struct {
-				ID     string
-				Number int
-				Title  string
-				Body   string
-				State  string
-				URL    string `graphql:"url"`
-				Author struct {
-					Login string
-				}
-				Assignees struct {
-					Nodes []struct {
-						Login string
-					}
-				} `graphql:"assignees(first: 10)"`
-				Labels struct {
-					Nodes []struct {
-						Name  string
-						Color string
-					}
-				} `graphql:"labels(first: 20)"`
-				Milestone struct {
-					Title string
-				}
+				issueFields
+				ProjectItems issueProjectItems `graphql:"projectItems(first: 20)"`
 			} `graphql:"issue(number: $number)"`
 		} `graphql:"repository(owner: $owner, name: $repo)"`
 	}
@@ -282,33 +360,227 @@ func (c *Client) GetIssue(owner, repo string, number int) (*Issue, error) {
 		return nil, fmt.Errorf("failed to get issue %s/%s#%d: %w", owner, repo, number, err)
 	}
 
-	issue := &Issue{
-		ID:     query.Repository.Issue.ID,
-		Number: query.Repository.Issue.Number,
-		Title:  query.Repository.Issue.Title,
-		Body:   query.Repository.Issue.Body,
-		State:  query.Repository.Issue.State,
-		URL:    query.Repository.Issue.URL,
-		Repository: Repository{
-			Owner: owner,
-			Name:  repo,
-		},
-		Author: Actor{Login: query.Repository.Issue.Author.Login},
+	issue := query.Repository.Issue.toIssue(owner, repo)
+
+	memberships, err := c.collectIssueProjects(issue.ID, query.Repository.Issue.ProjectItems)
+	if err != nil {
+		return nil, err
 	}
+	issue.ProjectMemberships = memberships
 
-	for _, a := range query.Repository.Issue.Assignees.Nodes {
-		issue.Assignees = append(issue.Assignees, Actor{Login: a.Login})
+	return issue, nil
+}
+
+// issueProjectItems is a page of an issue's projectItems connection, decoded
+// for project membership
+type issueProjectItems struct {
+	Nodes []struct {
+		Project struct {
+			ID     string
+			Title  string
+			Number int
+		}
 	}
+	PageInfo pageInfo
+}
 
-	for _, l := range query.Repository.Issue.Labels.Nodes {
-		issue.Labels = append(issue.Labels, Label{Name: l.Name, Color: l.Color})
+// collectIssueProjects returns the projects in first, fetching any further
+// pages of the issue's project items
+func (c *Client) collectIssueProjects(issueID string, first issueProjectItems) ([]ProjectRef, error) {
+	var projects []ProjectRef
+	guard := c.newPageGuard()
+	page := first
+	for {
+		for _, node := range page.Nodes {
+			projects = append(projects, ProjectRef{
+				ID:     node.Project.ID,
+				Title:  node.Project.Title,
+				Number: node.Project.Number,
+			})
+		}
+
+		cursor, err := guard.next(page.PageInfo)
+		if err != nil {
+			return nil, err
+		}
+		if cursor == nil {
+			return projects, nil
+		}
+
+		var query struct {
+			Node struct {
+				Issue struct {
+					ProjectItems issueProjectItems `graphql:"projectItems(first: 100, after: $cursor)"`
+				} `graphql:"... on Issue"`
+			} `graphql:"node(id: $issueId)"`
+		}
+		variables := map[string]interface{}{
+			"issueId": graphql.ID(issueID),
+			"cursor":  graphql.String(*cursor),
+		}
+		if err := c.gql.Query("GetIssueProjects", &query, variables); err != nil {
+			return nil, fmt.Errorf("failed to get issue projects: %w", err)
+		}
+		page = query.Node.Issue.ProjectItems
 	}
+}
 
-	if query.Repository.Issue.Milestone.Title != "" {
-		issue.Milestone = &Milestone{Title: query.Repository.Issue.Milestone.Title}
+// issueBatchSize caps how many aliased issue lookups GetIssues sends in one
+// request, keeping each query well inside GitHub's node limits
+const issueBatchSize = 25
+
+// batchIssueNode is one aliased issue in a GetIssues query
+type batchIssueNode struct {
+	issueFields
+}
+
+// GetIssues fetches many issues of one repository, batching them into
+// aliased lookups of up to issueBatchSize per request. Numbers that do not
+// resolve to an issue are absent from the map rather than an error. Project
+// memberships are not fetched; use GetIssue for those.
+func (c *Client) GetIssues(owner, repo string, numbers []int) (map[int]*Issue, error) {
+	issues := make(map[int]*Issue)
+	if len(numbers) == 0 {
+		return issues, nil
+	}
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
-	return issue, nil
+	for start := 0; start < len(numbers); start += issueBatchSize {
+		batch := numbers[start:min(start+issueBatchSize, len(numbers))]
+		if err := c.getIssuesBatch(owner, repo, batch, issues); err != nil {
+			return nil, err
+		}
+	}
+	return issues, nil
+}
+
+// getIssuesBatch fetches one batch of issues into issues
+func (c *Client) getIssuesBatch(owner, repo string, numbers []int, issues map[int]*Issue) error {
+	repository, err := c.queryIssueBatch("GetIssues", owner, repo, numbers, reflect.TypeOf(batchIssueNode{}))
+	if err != nil {
+		return fmt.Errorf("failed to get issues from %s/%s: %w", owner, repo, err)
+	}
+
+	for i := range numbers {
+		node := repository.Field(i).Interface().(batchIssueNode)
+		if node.ID == "" {
+			continue
+		}
+		issues[numbers[i]] = node.toIssue(owner, repo)
+	}
+	return nil
+}
+
+// queryIssueBatch runs a query selecting nodeType for each issue number under
+// the aliases i0, i1, ... and returns the decoded repository struct, whose
+// field i holds numbers[i]. The aliases are built at runtime, so the query
+// struct is too. A missing issue fails only its alias and is left zero.
+func (c *Client) queryIssueBatch(name, owner, repo string, numbers []int, nodeType reflect.Type) (reflect.Value, error) {
+	fields := make([]reflect.StructField, len(numbers))
+	for i, number := range numbers {
+		if _, err := safeGraphQLInt(number); err != nil {
+			return reflect.Value{}, err
+		}
+		fields[i] = reflect.StructField{
+			Name: fmt.Sprintf("I%d", i),
+			Type: nodeType,
+			Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"i%d: issue(number: %d)"`, i, number)),
+		}
+	}
+	queryType := reflect.StructOf([]reflect.StructField{{
+		Name: "Repository",
+		Type: reflect.StructOf(fields),
+		Tag:  `graphql:"repository(owner: $owner, name: $repo)"`,
+	}})
+	query := reflect.New(queryType)
+
+	variables := map[string]interface{}{
+		"owner": graphql.String(owner),
+		"repo":  graphql.String(repo),
+	}
+	// The rest of the data still decodes when an alias fails to resolve
+	err := c.gql.Query(name, query.Interface(), variables)
+	if err != nil && !strings.Contains(err.Error(), "Could not resolve to an Issue") {
+		return reflect.Value{}, err
+	}
+	return query.Elem().Field(0), nil
+}
+
+// closersIssueNode is one aliased issue in a GetIssueClosers query
+type closersIssueNode struct {
+	Number                         int
+	ClosedByPullRequestsReferences struct {
+		Nodes []struct {
+			Number int
+			Merged bool
+		}
+	} `graphql:"closedByPullRequestsReferences(first: 10, includeClosedPrs: true)"`
+}
+
+// GetIssueClosers reports, for each issue number, the pull requests that
+// reference it as closing it and whether they were merged. Issues closed
+// without a pull request have no closers; numbers that do not resolve to an
+// issue are absent from the map.
+func (c *Client) GetIssueClosers(owner, repo string, numbers []int) (map[int]IssueClosers, error) {
+	closers := make(map[int]IssueClosers)
+	if len(numbers) == 0 {
+		return closers, nil
+	}
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	for start := 0; start < len(numbers); start += issueBatchSize {
+		batch := numbers[start:min(start+issueBatchSize, len(numbers))]
+		repository, err := c.queryIssueBatch("GetIssueClosers", owner, repo, batch, reflect.TypeOf(closersIssueNode{}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get closing pull requests from %s/%s: %w", owner, repo, err)
+		}
+		for i, number := range batch {
+			node := repository.Field(i).Interface().(closersIssueNode)
+			if node.Number == 0 {
+				continue
+			}
+			summary := IssueClosers{Number: number}
+			for _, pr := range node.ClosedByPullRequestsReferences.Nodes {
+				if pr.Merged {
+					summary.MergedPRCount++
+				} else {
+					summary.HasUnmergedClosers = true
+				}
+			}
+			closers[number] = summary
+		}
+	}
+	return closers, nil
+}
+
+// GetIssueBody fetches the current body of an issue by its node ID
+func (c *Client) GetIssueBody(issueID string) (string, error) {
+	if c.gql == nil {
+		return "", fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Node struct {
+			Issue struct {
+				Body string
+			} `graphql:"... on Issue"`
+		} `graphql:"node(id: $id)"`
+	}
+
+	variables := map[string]interface{}{
+		"id": graphql.ID(issueID),
+	}
+
+	err := c.gql.Query("GetIssueBody", &query, variables)
+	if err != nil {
+		return "", fmt.Errorf("failed to get issue body: %w", err)
+	}
+
+	return query.Node.Issue.Body, nil
 }
 
 // GetIssueWithProjectFields fetches an issue and its project field values in a single query.
@@ -347,27 +619,7 @@ func (c *Client) GetIssueWithProjectFields(owner, repo string, number int) (*Iss
 				ProjectItems struct {
 					Nodes []struct {
 						FieldValues struct {
-							Nodes []struct {
-								TypeName string `graphql:"__typename"`
-								// Single select field value
-								ProjectV2ItemFieldSingleSelectValue struct {
-									Name  string
-									Field struct {
-										ProjectV2SingleSelectField struct {
-											Name string
-										} `graphql:"... on ProjectV2SingleSelectField"`
-									}
-								} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
-								// Text field value
-								ProjectV2ItemFieldTextValue struct {
-									Text  string
-									Field struct {
-										ProjectV2Field struct {
-											Name string
-										} `graphql:"... on ProjectV2Field"`
-									}
-								} `graphql:"... on ProjectV2ItemFieldTextValue"`
-							}
+							Nodes []fieldValueNode
 						} `graphql:"fieldValues(first: 20)"`
 					}
 				} `graphql:"projectItems(first: 10)"`
@@ -421,21 +673,8 @@ func (c *Client) GetIssueWithProjectFields(owner, repo string, number int) (*Iss
 	var fieldValues []FieldValue
 	for _, projectItem := range query.Repository.Issue.ProjectItems.Nodes {
 		for _, fv := range projectItem.FieldValues.Nodes {
-			switch fv.TypeName {
-			case "ProjectV2ItemFieldSingleSelectValue":
-				if fv.ProjectV2ItemFieldSingleSelectValue.Name != "" {
-					fieldValues = append(fieldValues, FieldValue{
-						Field: fv.ProjectV2ItemFieldSingleSelectValue.Field.ProjectV2SingleSelectField.Name,
-						Value: fv.ProjectV2ItemFieldSingleSelectValue.Name,
-					})
-				}
-			case "ProjectV2ItemFieldTextValue":
-				if fv.ProjectV2ItemFieldTextValue.Text != "" {
-					fieldValues = append(fieldValues, FieldValue{
-						Field: fv.ProjectV2ItemFieldTextValue.Field.ProjectV2Field.Name,
-						Value: fv.ProjectV2ItemFieldTextValue.Text,
-					})
-				}
+			if value, ok := fv.decode(); ok {
+				fieldValues = append(fieldValues, value)
 			}
 		}
 	}
@@ -493,136 +732,362 @@ func (c *Client) GetProjectItemIDForIssue(projectID, owner, repo string, number
 
 // ProjectItemsFilter allows filtering project items
 type ProjectItemsFilter struct {
-	Repository string  // Filter by repository (owner/repo format)
-	State      *string // Filter by issue state: "OPEN", "CLOSED", or nil for all
-	Limit      int     // Maximum number of items to return (0 = no limit)
+	Repository    string    // Filter by repository (owner/repo format)
+	State         *string   // Filter by issue state: "OPEN", "CLOSED", or nil for all
+	Limit         int       // Maximum number of items to return (0 = no limit)
+	CreatedAfter  time.Time // Only issues created after this time (zero = no bound)
+	CreatedBefore time.Time // Only issues created before this time (zero = no bound)
+	// IncludeArchived also fetches the archived project items, which the
+	// default items connection leaves out, flagged with Archived. They are
+	// fetched after the other items, and After applies to those only.
+	IncludeArchived bool
+	// After starts pagination after this cursor, e.g. the Cursor of a
+	// PartialResultError to resume an interrupted fetch
+	After string
+	// OrderBy orders the returned items; see the OrderBy constants. Empty
+	// keeps fetch order, which is board (position) order.
+	OrderBy string
+	// ContentTypes lists the item content types to return (ContentTypeIssue,
+	// ContentTypePullRequest, ContentTypeDraftIssue). Empty returns issues only.
+	ContentTypes []string
+	// OnPage, when set, is called after each page is fetched with the number
+	// of items fetched so far, before any filtering, e.g. to show progress
+	OnPage func(fetched int)
+}
+
+// includesContentType reports whether the filter returns items of typeName
+func (f *ProjectItemsFilter) includesContentType(typeName string) bool {
+	if f == nil || len(f.ContentTypes) == 0 {
+		return typeName == ContentTypeIssue
+	}
+	for _, t := range f.ContentTypes {
+		if t == typeName {
+			return true
+		}
+	}
+	return false
+}
+
+// contentType returns the content type of a decoded project item
+func (item ProjectItem) contentType() string {
+	switch {
+	case item.PullRequest != nil:
+		return ContentTypePullRequest
+	case item.DraftIssue != nil:
+		return ContentTypeDraftIssue
+	}
+	return ContentTypeIssue
+}
+
+// ProjectItemsFilter.OrderBy values. Position is the order GitHub shows on
+// the board and comes from the API; the others sort client-side after all
+// pages are fetched, breaking ties by issue number.
+const (
+	OrderByPosition = "position" // Board order
+	OrderByNumber   = "number"   // Issue number, ascending
+	OrderByTitle    = "title"    // Issue title, case-insensitive
+	OrderByCreated  = "created"  // Issue creation time, oldest first
+)
+
+// clientOrder returns the client-side sort key for the filter, or "" when
+// items stay in fetch order. An unsupported OrderBy warns and keeps fetch order.
+func (f *ProjectItemsFilter) clientOrder() string {
+	if f == nil {
+		return ""
+	}
+	switch f.OrderBy {
+	case "", OrderByPosition:
+		return ""
+	case OrderByNumber, OrderByTitle, OrderByCreated:
+		return f.OrderBy
+	}
+	fmt.Fprintf(os.Stderr, "Warning: unsupported item order %q; using board order\n", f.OrderBy)
+	return ""
+}
+
+// sortProjectItems orders items by a client-side OrderBy key, breaking ties
+// by issue number. Items without an issue sort last.
+func sortProjectItems(items []ProjectItem, orderBy string) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i].Issue, items[j].Issue
+		if a == nil || b == nil {
+			return a != nil
+		}
+		switch orderBy {
+		case OrderByTitle:
+			if c := strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title)); c != 0 {
+				return c < 0
+			}
+		case OrderByCreated:
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.Before(b.CreatedAt)
+			}
+		}
+		return a.Number < b.Number
+	})
+}
+
+// startCursor returns the cursor pagination starts after, nil for the first page
+func (f *ProjectItemsFilter) startCursor() *string {
+	if f == nil || f.After == "" {
+		return nil
+	}
+	after := f.After
+	return &after
+}
+
+// partialResult wraps a page error in a PartialResultError when earlier pages
+// succeeded. A failure on the first page returns err unchanged.
+func partialResult[T any](items []T, pages int, cursor *string, err error) error {
+	if pages == 0 || cursor == nil {
+		return err
+	}
+	return &PartialResultError[T]{Items: items, Cursor: *cursor, Err: err}
+}
+
+// matchesCreated reports whether issue falls inside the filter's creation
+// window. With either bound set, issues without a creation time are excluded.
+func (f *ProjectItemsFilter) matchesCreated(issue *Issue) bool {
+	if f.CreatedAfter.IsZero() && f.CreatedBefore.IsZero() {
+		return true
+	}
+	if issue == nil || issue.CreatedAt.IsZero() {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && !issue.CreatedAt.After(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !issue.CreatedAt.Before(f.CreatedBefore) {
+		return false
+	}
+	return true
 }
 
 // GetProjectItems fetches all items from a project with their field values.
 // Uses cursor-based pagination to retrieve all items regardless of project size.
 // If filter.Limit > 0, pagination terminates early once the limit is reached.
+// Items repeated across pages (overlapping cursors during concurrent edits) are
+// returned once, in first-seen order; the limit counts unique items.
+// Returns nil on error and a non-nil empty slice when no items match. When a
+// page after the first fails, the error is a *PartialResultError[ProjectItem]
+// holding the items fetched so far.
 func (c *Client) GetProjectItems(projectID string, filter *ProjectItemsFilter) ([]ProjectItem, error) {
 	if c.gql == nil {
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
-	var allItems []ProjectItem
-	var cursor *string
+	allItems := []ProjectItem{}
+	seen := make(map[string]bool)
+	cursor := filter.startCursor()
+	guard := c.newPageGuard()
 	limit := 0
 	if filter != nil {
 		limit = filter.Limit
 	}
+	// A client-side order needs every item before the limit can apply
+	orderBy := filter.clientOrder()
+	fetched := 0
+
+	// Archived items come from a second pass over the is:archived search
+	passes := []bool{false}
+	if filter != nil && filter.IncludeArchived {
+		passes = append(passes, true)
+	}
+	for _, archived := range passes {
+		if archived {
+			cursor = nil
+			guard = c.newPageGuard()
+		}
+		for pages := 0; ; pages++ {
+			items, pageInfo, err := c.getProjectItemsPage(projectID, cursor, archived)
+			if err != nil {
+				// A cursor into the archived pass could not resume the fetch
+				if archived {
+					return nil, err
+				}
+				return nil, partialResult(allItems, pages, cursor, err)
+			}
+			fetched += len(items)
+			if filter != nil && filter.OnPage != nil {
+				filter.OnPage(fetched)
+			}
 
-	for {
-		items, pageInfo, err := c.getProjectItemsPage(projectID, cursor)
-		if err != nil {
-			return nil, err
-		}
+			// Filter and process items from this page
+			for _, item := range items {
+				// Skip items already returned by an earlier page
+				if seen[item.ID] {
+					continue
+				}
 
-		// Filter and process items from this page
-		for _, item := range items {
-			// Apply repository filter if specified
-			if filter != nil && filter.Repository != "" {
-				if item.Issue != nil && item.Issue.Repository.Owner != "" {
-					repoName := item.Issue.Repository.Owner + "/" + item.Issue.Repository.Name
-					if repoName != filter.Repository {
+				// Only the requested content types, issues by default
+				if !filter.includesContentType(item.contentType()) {
+					continue
+				}
+
+				// Apply repository filter if specified
+				if filter != nil && filter.Repository != "" {
+					var repo Repository
+					if item.Issue != nil {
+						repo = item.Issue.Repository
+					} else if item.PullRequest != nil {
+						repo = item.PullRequest.Repository
+					}
+					if repo.Owner != "" && repo.Owner+"/"+repo.Name != filter.Repository {
 						continue
 					}
 				}
-			}
 
-			// Apply state filter if specified
-			if filter != nil && filter.State != nil {
-				if item.Issue == nil || item.Issue.State != *filter.State {
+				// Apply state filter if specified
+				if filter != nil && filter.State != nil {
+					state := ""
+					if item.Issue != nil {
+						state = item.Issue.State
+					} else if item.PullRequest != nil {
+						state = item.PullRequest.State
+					}
+					if state != *filter.State {
+						continue
+					}
+				}
+
+				// Apply creation window if specified
+				if filter != nil && !filter.matchesCreated(item.Issue) {
 					continue
 				}
+
+				seen[item.ID] = true
+				allItems = append(allItems, item)
+
+				// Early termination if limit is reached
+				if limit > 0 && orderBy == "" && len(allItems) >= limit {
+					return allItems[:limit], nil
+				}
 			}
 
-			allItems = append(allItems, item)
-
-			// Early termination if limit is reached
-			if limit > 0 && len(allItems) >= limit {
-				return allItems[:limit], nil
+			// Check if there are more pages
+			next, err := guard.next(pageInfo)
+			if err != nil {
+				return nil, err
 			}
+			if next == nil {
+				break
+			}
+			cursor = next
 		}
+	}
 
-		// Check if there are more pages
-		if !pageInfo.HasNextPage {
-			break
+	if orderBy != "" {
+		sortProjectItems(allItems, orderBy)
+		if limit > 0 && len(allItems) > limit {
+			allItems = allItems[:limit]
 		}
-		cursor = &pageInfo.EndCursor
 	}
 
 	return allItems, nil
 }
 
-// pageInfo holds pagination information from GraphQL responses
-type pageInfo struct {
-	HasNextPage bool
-	EndCursor   string
+// DefaultMaxPages is the most pages a single paginated call fetches when
+// ClientOptions.MaxPages is unset
+const DefaultMaxPages = 1000
+
+// pageGuard stops pagination loops that would otherwise run forever on a
+// response that keeps reporting another page
+type pageGuard struct {
+	maxPages int
+	pages    int
+	cursor   string
+}
+
+// newPageGuard returns a guard using the client's page cap
+func (c *Client) newPageGuard() *pageGuard {
+	maxPages := c.opts.MaxPages
+	if maxPages <= 0 {
+		maxPages = DefaultMaxPages
+	}
+	return &pageGuard{maxPages: maxPages}
+}
+
+// next records a fetched page and returns the cursor for the following one,
+// or nil when pagination is complete. It errors when the cursor did not
+// advance or the page cap is reached.
+func (g *pageGuard) next(pi pageInfo) (*string, error) {
+	g.pages++
+	if !pi.HasNextPage {
+		return nil, nil
+	}
+	if pi.EndCursor == g.cursor {
+		return nil, ErrPaginationStalled
+	}
+	if g.pages >= g.maxPages {
+		return nil, fmt.Errorf("%w (%d pages)", ErrPageLimitExceeded, g.maxPages)
+	}
+	g.cursor = pi.EndCursor
+	cursor := pi.EndCursor
+	return &cursor, nil
+}
+
+// projectItemNode is one item of a GetProjectItems page
+type projectItemNode struct {
+	ID         string
+	IsArchived bool
+	UpdatedAt  string
+	Content    struct {
+		TypeName string `graphql:"__typename"`
+		Issue    struct {
+			issueSummaryFields
+			Body       string
+			CreatedAt  string
+			Repository repositoryRef
+			Milestone  struct {
+				Title string
+			}
+			Assignees struct {
+				Nodes []struct {
+					Login string
+				}
+			} `graphql:"assignees(first: 10)"`
+			Labels struct {
+				Nodes []struct {
+					Name string
+				}
+			} `graphql:"labels(first: 20)"`
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			issueSummaryFields
+			Repository repositoryRef
+		} `graphql:"... on PullRequest"`
+		DraftIssue struct {
+			ID    string
+			Title string
+			Body  string
+		} `graphql:"... on DraftIssue"`
+	}
+	FieldValues struct {
+		Nodes []fieldValueNode
+	} `graphql:"fieldValues(first: 20)"`
+}
+
+// projectItemsConnection is a page of project items
+type projectItemsConnection struct {
+	Nodes    []projectItemNode
+	PageInfo pageInfo
 }
 
-// getProjectItemsPage fetches a single page of project items
-func (c *Client) getProjectItemsPage(projectID string, cursor *string) ([]ProjectItem, pageInfo, error) {
+// getProjectItemsPage fetches a single page of project items. The default
+// items connection leaves archived items out; archived fetches the archived
+// items instead, through the connection's is:archived search.
+func (c *Client) getProjectItemsPage(projectID string, cursor *string, archived bool) ([]ProjectItem, pageInfo, error) {
 	var query struct {
 		Node struct {
 			ProjectV2 struct {
-				Items struct {
-					Nodes []struct {
-						ID      string
-						Content struct {
-							TypeName string `graphql:"__typename"`
-							Issue    struct {
-								ID         string
-								Number     int
-								Title      string
-								Body       string
-								State      string
-								URL        string `graphql:"url"`
-								Repository struct {
-									NameWithOwner string
-								}
-								Assignees struct {
-									Nodes []struct {
-										Login string
-									}
-								} `graphql:"assignees(first: 10)"`
-								Labels struct {
-									Nodes []struct {
-										Name string
-									}
-								} `graphql:"labels(first: 20)"`
-							} `graphql:"... on Issue"`
-						}
-						FieldValues struct {
-							Nodes []struct {
-								TypeName string `graphql:"__typename"`
-								// Single select field value
-								ProjectV2ItemFieldSingleSelectValue struct {
-									Name  string
-									Field struct {
-										ProjectV2SingleSelectField struct {
-											Name string
-										} `graphql:"... on ProjectV2SingleSelectField"`
-									}
-								} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
-								// Text field value
-								ProjectV2ItemFieldTextValue struct {
-									Text  string
-									Field struct {
-										ProjectV2Field struct {
-											Name string
-										} `graphql:"... on ProjectV2Field"`
-									}
-								} `graphql:"... on ProjectV2ItemFieldTextValue"`
-							}
-						} `graphql:"fieldValues(first: 20)"`
-					}
-					PageInfo struct {
-						HasNextPage bool
-						EndCursor   string
-					}
-				} `graphql:"items(first: 100, after: $cursor)"`
+				Items projectItemsConnection `graphql:"items(first: 100, after: $cursor, orderBy: {field: POSITION, direction: ASC})"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $projectId)"`
+	}
+	var archivedQuery struct {
+		Node struct {
+			ProjectV2 struct {
+				Items projectItemsConnection `graphql:"items(first: 100, after: $cursor, orderBy: {field: POSITION, direction: ASC}, query: \"is:archived\")"`
 			} `graphql:"... on ProjectV2"`
 		} `graphql:"node(id: $projectId)"`
 	}
@@ -635,40 +1100,69 @@ func (c *Client) getProjectItemsPage(projectID string, cursor *string) ([]Projec
 		variables["cursor"] = graphql.String(*cursor)
 	}
 
-	err := c.gql.Query("GetProjectItems", &query, variables)
+	page := &query.Node.ProjectV2.Items
+	name := "GetProjectItems"
+	var err error
+	if archived {
+		page = &archivedQuery.Node.ProjectV2.Items
+		name = "GetArchivedProjectItems"
+		err = c.gql.Query(name, &archivedQuery, variables)
+	} else {
+		err = c.gql.Query(name, &query, variables)
+	}
 	if err != nil {
 		return nil, pageInfo{}, fmt.Errorf("failed to get project items: %w", err)
 	}
 
 	var items []ProjectItem
-	for _, node := range query.Node.ProjectV2.Items.Nodes {
-		// Skip non-issue items (like draft issues or PRs)
-		if node.Content.TypeName != "Issue" {
-			continue
-		}
-
+	for _, node := range page.Nodes {
 		item := ProjectItem{
-			ID: node.ID,
-			Issue: &Issue{
-				ID:     node.Content.Issue.ID,
-				Number: node.Content.Issue.Number,
-				Title:  node.Content.Issue.Title,
-				Body:   node.Content.Issue.Body,
-				State:  node.Content.Issue.State,
-				URL:    node.Content.Issue.URL,
-			},
+			ID:       node.ID,
+			Archived: node.IsArchived,
+		}
+		if updated, err := time.Parse(time.RFC3339, node.UpdatedAt); err == nil {
+			item.UpdatedAt = updated
+		}
+
+		// Pull requests and drafts carry no milestone, labels, or assignees here
+		switch node.Content.TypeName {
+		case ContentTypePullRequest:
+			pr := node.Content.PullRequest
+			item.PullRequest = &PullRequest{
+				ID:     pr.ID,
+				Number: pr.Number,
+				Title:  pr.Title,
+				State:  pr.State,
+				URL:    pr.URL,
+			}
+			if repository, ok := pr.Repository.toRepository(); ok {
+				item.PullRequest.Repository = repository
+			}
+			item.FieldValues = decodeFieldValues(node.FieldValues.Nodes, "", pr.Repository.NameWithOwner)
+			items = append(items, item)
+			continue
+		case ContentTypeDraftIssue:
+			item.DraftIssue = &DraftIssue{
+				ID:    node.Content.DraftIssue.ID,
+				Title: node.Content.DraftIssue.Title,
+				Body:  node.Content.DraftIssue.Body,
+			}
+			item.FieldValues = decodeFieldValues(node.FieldValues.Nodes, "", "")
+			items = append(items, item)
+			continue
+		case ContentTypeIssue:
+		default:
+			// Redacted or unknown content
+			continue
 		}
 
-		// Parse repository
-		if node.Content.Issue.Repository.NameWithOwner != "" {
-			parts := splitRepoName(node.Content.Issue.Repository.NameWithOwner)
-			if len(parts) == 2 {
-				item.Issue.Repository = Repository{
-					Owner: parts[0],
-					Name:  parts[1],
-				}
-			}
+		repository, _ := node.Content.Issue.Repository.toRepository()
+		issue := node.Content.Issue.toIssue(repository.Owner, repository.Name)
+		issue.Body = node.Content.Issue.Body
+		if created, err := time.Parse(time.RFC3339, node.Content.Issue.CreatedAt); err == nil {
+			issue.CreatedAt = created
 		}
+		item.Issue = &issue
 
 		// Parse assignees
 		for _, a := range node.Content.Issue.Assignees.Nodes {
@@ -680,33 +1174,178 @@ func (c *Client) getProjectItemsPage(projectID string, cursor *string) ([]Projec
 			item.Issue.Labels = append(item.Issue.Labels, Label{Name: l.Name})
 		}
 
-		// Parse field values
-		for _, fv := range node.FieldValues.Nodes {
-			switch fv.TypeName {
-			case "ProjectV2ItemFieldSingleSelectValue":
-				if fv.ProjectV2ItemFieldSingleSelectValue.Name != "" {
-					item.FieldValues = append(item.FieldValues, FieldValue{
-						Field: fv.ProjectV2ItemFieldSingleSelectValue.Field.ProjectV2SingleSelectField.Name,
-						Value: fv.ProjectV2ItemFieldSingleSelectValue.Name,
-					})
-				}
-			case "ProjectV2ItemFieldTextValue":
-				if fv.ProjectV2ItemFieldTextValue.Text != "" {
-					item.FieldValues = append(item.FieldValues, FieldValue{
-						Field: fv.ProjectV2ItemFieldTextValue.Field.ProjectV2Field.Name,
-						Value: fv.ProjectV2ItemFieldTextValue.Text,
-					})
-				}
-			}
+		if node.Content.Issue.Milestone.Title != "" {
+			item.Issue.Milestone = &Milestone{Title: node.Content.Issue.Milestone.Title}
 		}
 
+		item.FieldValues = decodeFieldValues(node.FieldValues.Nodes,
+			node.Content.Issue.Milestone.Title, node.Content.Issue.Repository.NameWithOwner)
+
 		items = append(items, item)
 	}
 
-	return items, pageInfo{
-		HasNextPage: query.Node.ProjectV2.Items.PageInfo.HasNextPage,
-		EndCursor:   query.Node.ProjectV2.Items.PageInfo.EndCursor,
-	}, nil
+	return items, page.PageInfo, nil
+}
+
+// decodeFieldValues decodes an item's field value nodes and appends the
+// milestone and repository pseudo-fields
+func decodeFieldValues(nodes []fieldValueNode, milestone, repository string) []FieldValue {
+	var values []FieldValue
+	for _, fv := range nodes {
+		if value, ok := fv.decode(); ok {
+			values = append(values, value)
+		}
+	}
+	return appendPseudoFieldValues(values, milestone, repository)
+}
+
+// appendPseudoFieldValues adds the milestone and repository pseudo-fields to
+// decoded field values. The milestone entry is always present, empty when unset.
+func appendPseudoFieldValues(values []FieldValue, milestone, repository string) []FieldValue {
+	return append(values,
+		FieldValue{Field: MilestoneFieldName, Value: milestone},
+		FieldValue{Field: RepositoryFieldName, Value: repository},
+	)
+}
+
+// fieldValueNode is one entry of a project item's fieldValues connection,
+// shared by every query that decodes field values
+type fieldValueNode struct {
+	TypeName string `graphql:"__typename"`
+	// Single select field value
+	ProjectV2ItemFieldSingleSelectValue struct {
+		Name     string
+		OptionID string `graphql:"optionId"`
+		Field    struct {
+			ProjectV2SingleSelectField struct {
+				ID   string
+				Name string
+			} `graphql:"... on ProjectV2SingleSelectField"`
+		}
+	} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+	// Text field value
+	ProjectV2ItemFieldTextValue struct {
+		Text  string
+		Field struct {
+			ProjectV2Field struct {
+				ID   string
+				Name string
+			} `graphql:"... on ProjectV2Field"`
+		}
+	} `graphql:"... on ProjectV2ItemFieldTextValue"`
+	// Number field value
+	ProjectV2ItemFieldNumberValue struct {
+		Number float64
+		Field  struct {
+			ProjectV2Field struct {
+				ID   string
+				Name string
+			} `graphql:"... on ProjectV2Field"`
+		}
+	} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+	// Date field value
+	ProjectV2ItemFieldDateValue struct {
+		Date  string
+		Field struct {
+			ProjectV2Field struct {
+				ID   string
+				Name string
+			} `graphql:"... on ProjectV2Field"`
+		}
+	} `graphql:"... on ProjectV2ItemFieldDateValue"`
+	// Iteration field value
+	ProjectV2ItemFieldIterationValue struct {
+		Title       string
+		IterationID string `graphql:"iterationId"`
+		StartDate   string
+		Duration    int
+		Field       struct {
+			ProjectV2IterationField struct {
+				ID   string
+				Name string
+			} `graphql:"... on ProjectV2IterationField"`
+		}
+	} `graphql:"... on ProjectV2ItemFieldIterationValue"`
+}
+
+// fieldID returns the ID of the project field the value belongs to
+func (n fieldValueNode) fieldID() string {
+	switch n.TypeName {
+	case "ProjectV2ItemFieldSingleSelectValue":
+		return n.ProjectV2ItemFieldSingleSelectValue.Field.ProjectV2SingleSelectField.ID
+	case "ProjectV2ItemFieldTextValue":
+		return n.ProjectV2ItemFieldTextValue.Field.ProjectV2Field.ID
+	case "ProjectV2ItemFieldNumberValue":
+		return n.ProjectV2ItemFieldNumberValue.Field.ProjectV2Field.ID
+	case "ProjectV2ItemFieldDateValue":
+		return n.ProjectV2ItemFieldDateValue.Field.ProjectV2Field.ID
+	case "ProjectV2ItemFieldIterationValue":
+		return n.ProjectV2ItemFieldIterationValue.Field.ProjectV2IterationField.ID
+	}
+	return ""
+}
+
+// decode converts the node to a FieldValue, reporting false for empty values
+// and field types that are not decoded
+func (n fieldValueNode) decode() (FieldValue, bool) {
+	switch n.TypeName {
+	case "ProjectV2ItemFieldSingleSelectValue":
+		v := n.ProjectV2ItemFieldSingleSelectValue
+		if v.Name == "" {
+			return FieldValue{}, false
+		}
+		return FieldValue{
+			Field:    v.Field.ProjectV2SingleSelectField.Name,
+			Value:    v.Name,
+			Kind:     FieldValueSingleSelect,
+			OptionID: v.OptionID,
+		}, true
+	case "ProjectV2ItemFieldTextValue":
+		v := n.ProjectV2ItemFieldTextValue
+		if v.Text == "" {
+			return FieldValue{}, false
+		}
+		return FieldValue{
+			Field: v.Field.ProjectV2Field.Name,
+			Value: v.Text,
+			Kind:  FieldValueText,
+		}, true
+	case "ProjectV2ItemFieldNumberValue":
+		v := n.ProjectV2ItemFieldNumberValue
+		return FieldValue{
+			Field:  v.Field.ProjectV2Field.Name,
+			Value:  strconv.FormatFloat(v.Number, 'f', -1, 64),
+			Kind:   FieldValueNumber,
+			Number: v.Number,
+		}, true
+	case "ProjectV2ItemFieldDateValue":
+		v := n.ProjectV2ItemFieldDateValue
+		if v.Date == "" {
+			return FieldValue{}, false
+		}
+		date, _ := time.Parse("2006-01-02", v.Date)
+		return FieldValue{
+			Field: v.Field.ProjectV2Field.Name,
+			Value: v.Date,
+			Kind:  FieldValueDate,
+			Date:  date,
+		}, true
+	case "ProjectV2ItemFieldIterationValue":
+		v := n.ProjectV2ItemFieldIterationValue
+		if v.Title == "" {
+			return FieldValue{}, false
+		}
+		start, _ := time.Parse("2006-01-02", v.StartDate)
+		return FieldValue{
+			Field:    v.Field.ProjectV2IterationField.Name,
+			Value:    v.Title,
+			Kind:     FieldValueIteration,
+			Date:     start,
+			OptionID: v.IterationID,
+			Duration: v.Duration,
+		}, true
+	}
+	return FieldValue{}, false
 }
 
 // splitRepoName splits "owner/repo" into parts
@@ -724,18 +1363,21 @@ func splitRepoName(nameWithOwner string) []string {
 // full issue details (Body, Title, Assignees, Labels) which can be large.
 // Use this for two-phase queries: first filter with minimal data, then fetch full details
 // for matching items only.
+// Returns nil on error and a non-nil empty slice when no items match. As with
+// GetProjectItems, a failure after the first page is a *PartialResultError.
 func (c *Client) GetProjectItemsMinimal(projectID string, filter *ProjectItemsFilter) ([]MinimalProjectItem, error) {
 	if c.gql == nil {
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
-	var allItems []MinimalProjectItem
-	var cursor *string
+	allItems := []MinimalProjectItem{}
+	cursor := filter.startCursor()
+	guard := c.newPageGuard()
 
-	for {
+	for pages := 0; ; pages++ {
 		items, pInfo, err := c.getMinimalProjectItemsPage(projectID, cursor)
 		if err != nil {
-			return nil, err
+			return nil, partialResult(allItems, pages, cursor, err)
 		}
 
 		// Filter and process items from this page
@@ -757,10 +1399,14 @@ func (c *Client) GetProjectItemsMinimal(projectID string, filter *ProjectItemsFi
 			allItems = append(allItems, item)
 		}
 
-		if !pInfo.HasNextPage {
+		next, err := guard.next(pInfo)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
 			break
 		}
-		cursor = &pInfo.EndCursor
+		cursor = next
 	}
 
 	return allItems, nil
@@ -782,37 +1428,17 @@ func (c *Client) getMinimalProjectItemsPage(projectID string, cursor *string) ([
 								Repository struct {
 									NameWithOwner string
 								}
+								Milestone struct {
+									Title string
+								}
 							} `graphql:"... on Issue"`
 						}
 						FieldValues struct {
-							Nodes []struct {
-								TypeName string `graphql:"__typename"`
-								// Single select field value
-								ProjectV2ItemFieldSingleSelectValue struct {
-									Name  string
-									Field struct {
-										ProjectV2SingleSelectField struct {
-											Name string
-										} `graphql:"... on ProjectV2SingleSelectField"`
-									}
-								} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
-								// Text field value
-								ProjectV2ItemFieldTextValue struct {
-									Text  string
-									Field struct {
-										ProjectV2Field struct {
-											Name string
-										} `graphql:"... on ProjectV2Field"`
-									}
-								} `graphql:"... on ProjectV2ItemFieldTextValue"`
-							}
+							Nodes []fieldValueNode
 						} `graphql:"fieldValues(first: 20)"`
 					}
-					PageInfo struct {
-						HasNextPage bool
-						EndCursor   string
-					}
-				} `graphql:"items(first: 100, after: $cursor)"`
+					PageInfo pageInfo
+				} `graphql:"items(first: 100, after: $cursor, orderBy: {field: POSITION, direction: ASC})"`
 			} `graphql:"... on ProjectV2"`
 		} `graphql:"node(id: $projectId)"`
 	}
@@ -846,31 +1472,18 @@ func (c *Client) getMinimalProjectItemsPage(projectID string, cursor *string) ([
 
 		// Parse field values
 		for _, fv := range node.FieldValues.Nodes {
-			switch fv.TypeName {
-			case "ProjectV2ItemFieldSingleSelectValue":
-				if fv.ProjectV2ItemFieldSingleSelectValue.Name != "" {
-					item.FieldValues = append(item.FieldValues, FieldValue{
-						Field: fv.ProjectV2ItemFieldSingleSelectValue.Field.ProjectV2SingleSelectField.Name,
-						Value: fv.ProjectV2ItemFieldSingleSelectValue.Name,
-					})
-				}
-			case "ProjectV2ItemFieldTextValue":
-				if fv.ProjectV2ItemFieldTextValue.Text != "" {
-					item.FieldValues = append(item.FieldValues, FieldValue{
-						Field: fv.ProjectV2ItemFieldTextValue.Field.ProjectV2Field.Name,
-						Value: fv.ProjectV2ItemFieldTextValue.Text,
-					})
-				}
+			if value, ok := fv.decode(); ok {
+				item.FieldValues = append(item.FieldValues, value)
 			}
 		}
 
+		item.FieldValues = appendPseudoFieldValues(item.FieldValues,
+			node.Content.Issue.Milestone.Title, node.Content.Issue.Repository.NameWithOwner)
+
 		items = append(items, item)
 	}
 
-	return items, pageInfo{
-		HasNextPage: query.Node.ProjectV2.Items.PageInfo.HasNextPage,
-		EndCursor:   query.Node.ProjectV2.Items.PageInfo.EndCursor,
-	}, nil
+	return items, query.Node.ProjectV2.Items.PageInfo, nil
 }
 
 // buildGraphQLRequestBody wraps a GraphQL query string in the JSON request format
@@ -924,13 +1537,18 @@ func (c *Client) GetProjectItemsByIssues(projectID string, refs []IssueRef) ([]P
 				title
 				body
 				state
+				stateReason
 				url
+				createdAt
+				closedAt
+				updatedAt
 				repository { nameWithOwner }
 				assignees(first: 10) { nodes { login } }
 				labels(first: 20) { nodes { name } }
-				projectItems(first: 20) {
+				projectItems(first: 20, includeArchived: true) {
 					nodes {
 						id
+						isArchived
 						project { id }
 						fieldValues(first: 20) {
 							nodes {
@@ -1007,13 +1625,14 @@ func (c *Client) GetProjectItemsByIssues(projectID string, refs []IssueRef) ([]P
 			}
 
 			var issue struct {
-				ID         string `json:"id"`
-				Number     int    `json:"number"`
-				Title      string `json:"title"`
-				Body       string `json:"body"`
-				State      string `json:"state"`
-				URL        string `json:"url"`
-				Repository struct {
+				ID          string `json:"id"`
+				Number      int    `json:"number"`
+				Title       string `json:"title"`
+				Body        string `json:"body"`
+				State       string `json:"state"`
+				StateReason string `json:"stateReason"`
+				URL         string `json:"url"`
+				Repository  struct {
 					NameWithOwner string `json:"nameWithOwner"`
 				} `json:"repository"`
 				Assignees struct {
@@ -1028,8 +1647,9 @@ func (c *Client) GetProjectItemsByIssues(projectID string, refs []IssueRef) ([]P
 				} `json:"labels"`
 				ProjectItems struct {
 					Nodes []struct {
-						ID      string `json:"id"`
-						Project struct {
+						ID         string `json:"id"`
+						IsArchived bool   `json:"isArchived"`
+						Project    struct {
 							ID string `json:"id"`
 						} `json:"project"`
 						FieldValues struct {
@@ -1044,6 +1664,9 @@ func (c *Client) GetProjectItemsByIssues(projectID string, refs []IssueRef) ([]P
 						} `json:"fieldValues"`
 					} `json:"nodes"`
 				} `json:"projectItems"`
+				CreatedAt time.Time `json:"createdAt"`
+				ClosedAt  time.Time `json:"closedAt"` // null while open
+				UpdatedAt time.Time `json:"updatedAt"`
 			}
 
 			if err := json.Unmarshal(issueData, &issue); err != nil {
@@ -1101,12 +1724,16 @@ func (c *Client) GetProjectItemsByIssues(projectID string, refs []IssueRef) ([]P
 				items = append(items, ProjectItem{
 					ID: pItem.ID,
 					Issue: &Issue{
-						ID:     issue.ID,
-						Number: issue.Number,
-						Title:  issue.Title,
-						Body:   issue.Body,
-						State:  issue.State,
-						URL:    issue.URL,
+						ID:          issue.ID,
+						Number:      issue.Number,
+						Title:       issue.Title,
+						Body:        issue.Body,
+						State:       issue.State,
+						StateReason: issue.StateReason,
+						URL:         issue.URL,
+						CreatedAt:   issue.CreatedAt,
+						ClosedAt:    issue.ClosedAt,
+						UpdatedAt:   issue.UpdatedAt,
 						Repository: Repository{
 							Owner: repoOwner,
 							Name:  repoName,
@@ -1115,6 +1742,7 @@ func (c *Client) GetProjectItemsByIssues(projectID string, refs []IssueRef) ([]P
 						Labels:    labels,
 					},
 					FieldValues: fieldValues,
+					Archived:    pItem.IsArchived,
 				})
 				break // Found the item for this project, move to next issue
 			}
@@ -1140,6 +1768,7 @@ func (c *Client) GetProjectItemsForBoard(projectID string, filter *BoardItemsFil
 
 	var allItems []BoardItem
 	var cursor *string
+	guard := c.newPageGuard()
 
 	for {
 		items, pInfo, err := c.getBoardItemsPage(projectID, cursor)
@@ -1165,10 +1794,14 @@ func (c *Client) GetProjectItemsForBoard(projectID string, filter *BoardItemsFil
 			allItems = append(allItems, item)
 		}
 
-		if !pInfo.HasNextPage {
+		next, err := guard.next(pInfo)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
 			break
 		}
-		cursor = &pInfo.EndCursor
+		cursor = next
 	}
 
 	return allItems, nil
@@ -1206,10 +1839,7 @@ func (c *Client) getBoardItemsPage(projectID string, cursor *string) ([]BoardIte
 							}
 						} `graphql:"fieldValues(first: 10)"`
 					}
-					PageInfo struct {
-						HasNextPage bool
-						EndCursor   string
-					}
+					PageInfo pageInfo
 				} `graphql:"items(first: 100, after: $cursor)"`
 			} `graphql:"... on ProjectV2"`
 		} `graphql:"node(id: $projectId)"`
@@ -1259,20 +1889,19 @@ func (c *Client) getBoardItemsPage(projectID string, cursor *string) ([]BoardIte
 		items = append(items, item)
 	}
 
-	return items, pageInfo{
-		HasNextPage: query.Node.ProjectV2.Items.PageInfo.HasNextPage,
-		EndCursor:   query.Node.ProjectV2.Items.PageInfo.EndCursor,
-	}, nil
+	return items, query.Node.ProjectV2.Items.PageInfo, nil
 }
 
-// GetSubIssues fetches all sub-issues for a given issue with pagination support
+// GetSubIssues fetches all sub-issues for a given issue with pagination support.
+// Returns nil on error and a non-nil empty slice when the issue has no sub-issues.
 func (c *Client) GetSubIssues(owner, repo string, number int) ([]SubIssue, error) {
 	if c.gql == nil {
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
-	var subIssues []SubIssue
+	subIssues := []SubIssue{}
 	var cursor *graphql.String
+	guard := c.newPageGuard()
 	pageCount := 0
 
 	for {
@@ -1293,10 +1922,7 @@ func (c *Client) GetSubIssues(owner, repo string, number int) ([]SubIssue, error
 								}
 							}
 						}
-						PageInfo struct {
-							HasNextPage bool
-							EndCursor   string
-						}
+						PageInfo pageInfo
 					} `graphql:"subIssues(first: 100, after: $cursor)"`
 				} `graphql:"issue(number: $number)"`
 			} `graphql:"repository(owner: $owner, name: $repo)"`
@@ -1334,11 +1960,15 @@ func (c *Client) GetSubIssues(owner, repo string, number int) ([]SubIssue, error
 		}
 
 		pageCount++
-		if !query.Repository.Issue.SubIssues.PageInfo.HasNextPage {
+		next, err := guard.next(query.Repository.Issue.SubIssues.PageInfo)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
 			break
 		}
 
-		endCursor := graphql.String(query.Repository.Issue.SubIssues.PageInfo.EndCursor)
+		endCursor := graphql.String(*next)
 		cursor = &endCursor
 
 		// Warn if we're fetching many pages (performance awareness)
@@ -1575,7 +2205,8 @@ func parseSubIssuesBatchResponse(data []byte, numbers []int) (map[int][]SubIssue
 	return result, needsPagination, nil
 }
 
-// GetRepositoryIssues fetches issues from a repository with the given state filter
+// GetRepositoryIssues fetches issues from a repository with the given state filter.
+// Returns nil on error and a non-nil empty slice when the repository has no matching issues.
 func (c *Client) GetRepositoryIssues(owner, repo, state string) ([]Issue, error) {
 	if c.gql == nil {
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
@@ -1595,8 +2226,9 @@ func (c *Client) GetRepositoryIssues(owner, repo, state string) ([]Issue, error)
 	}
 
 	// Use cursor-based pagination to fetch all issues
-	var allIssues []Issue
+	allIssues := []Issue{}
 	var cursor *string
+	guard := c.newPageGuard()
 
 	for {
 		issues, pi, err := c.getRepositoryIssuesPage(owner, repo, states, cursor)
@@ -1605,10 +2237,14 @@ func (c *Client) GetRepositoryIssues(owner, repo, state string) ([]Issue, error)
 		}
 		allIssues = append(allIssues, issues...)
 
-		if !pi.HasNextPage {
+		next, err := guard.next(pi)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
 			break
 		}
-		cursor = &pi.EndCursor
+		cursor = next
 	}
 
 	return allIssues, nil
@@ -1619,17 +2255,8 @@ func (c *Client) getRepositoryIssuesPage(owner, repo string, states []IssueState
 	var query struct {
 		Repository struct {
 			Issues struct {
-				Nodes []struct {
-					ID     string
-					Number int
-					Title  string
-					State  string
-					URL    string `graphql:"url"`
-				}
-				PageInfo struct {
-					HasNextPage bool
-					EndCursor   string
-				}
+				Nodes    []issueSummaryFields
+				PageInfo pageInfo
 			} `graphql:"issues(first: 100, after: $cursor, states: $states)"`
 		} `graphql:"repository(owner: $owner, name: $repo)"`
 	}
@@ -1651,28 +2278,16 @@ func (c *Client) getRepositoryIssuesPage(owner, repo string, states []IssueState
 
 	var issues []Issue
 	for _, node := range query.Repository.Issues.Nodes {
-		issues = append(issues, Issue{
-			ID:     node.ID,
-			Number: node.Number,
-			Title:  node.Title,
-			State:  node.State,
-			URL:    node.URL,
-			Repository: Repository{
-				Owner: owner,
-				Name:  repo,
-			},
-		})
+		issues = append(issues, node.toIssue(owner, repo))
 	}
 
-	return issues, pageInfo{
-		HasNextPage: query.Repository.Issues.PageInfo.HasNextPage,
-		EndCursor:   query.Repository.Issues.PageInfo.EndCursor,
-	}, nil
+	return issues, query.Repository.Issues.PageInfo, nil
 }
 
 // SearchRepositoryIssues searches for issues in a repository using GitHub Search API.
 // This is more efficient than fetching all issues when filtering by state, labels, or text.
 // The limit parameter controls maximum results (0 = no limit, uses pagination).
+// Returns nil on error and a non-nil empty slice when the search has no results.
 func (c *Client) SearchRepositoryIssues(owner, repo string, filters SearchFilters, limit int) ([]Issue, error) {
 	if c.gql == nil {
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
@@ -1713,8 +2328,9 @@ func (c *Client) SearchRepositoryIssues(owner, repo string, filters SearchFilter
 
 	searchQuery := strings.Join(queryParts, " ")
 
-	var allIssues []Issue
+	allIssues := []Issue{}
 	var cursor *string
+	guard := c.newPageGuard()
 	pageSize := 100
 	if limit > 0 && limit < pageSize {
 		pageSize = limit
@@ -1739,10 +2355,14 @@ func (c *Client) SearchRepositoryIssues(owner, repo string, filters SearchFilter
 			}
 		}
 
-		if !pageInfo.HasNextPage {
+		next, err := guard.next(pageInfo)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
 			break
 		}
-		cursor = &pageInfo.EndCursor
+		cursor = next
 	}
 
 	return allIssues, nil
@@ -1901,38 +2521,11 @@ func (c *Client) searchIssuesPage(query string, pageSize int, cursor *string) ([
 			Nodes []struct {
 				TypeName string `graphql:"__typename"`
 				Issue    struct {
-					ID         string
-					Number     int
-					Title      string
-					Body       string
-					State      string
-					URL        string `graphql:"url"`
-					Repository struct {
-						NameWithOwner string
-					}
-					Author struct {
-						Login string
-					}
-					Assignees struct {
-						Nodes []struct {
-							Login string
-						}
-					} `graphql:"assignees(first: 10)"`
-					Labels struct {
-						Nodes []struct {
-							Name  string
-							Color string
-						}
-					} `graphql:"labels(first: 20)"`
-					Milestone struct {
-						Title string
-					}
+					issueFields
+					Repository repositoryRef
 				} `graphql:"... on Issue"`
 			}
-			PageInfo struct {
-				HasNextPage bool
-				EndCursor   string
-			}
+			PageInfo pageInfo
 		} `graphql:"search(query: $query, type: ISSUE, first: $first, after: $cursor)"`
 	}
 
@@ -1961,52 +2554,16 @@ func (c *Client) searchIssuesPage(query string, pageSize int, cursor *string) ([
 			continue
 		}
 
-		issue := Issue{
-			ID:     node.Issue.ID,
-			Number: node.Issue.Number,
-			Title:  node.Issue.Title,
-			Body:   node.Issue.Body,
-			State:  node.Issue.State,
-			URL:    node.Issue.URL,
-			Author: Actor{Login: node.Issue.Author.Login},
-		}
-
-		// Parse repository
-		if node.Issue.Repository.NameWithOwner != "" {
-			parts := splitRepoName(node.Issue.Repository.NameWithOwner)
-			if len(parts) == 2 {
-				issue.Repository = Repository{
-					Owner: parts[0],
-					Name:  parts[1],
-				}
-			}
-		}
-
-		// Parse assignees
-		for _, a := range node.Issue.Assignees.Nodes {
-			issue.Assignees = append(issue.Assignees, Actor{Login: a.Login})
-		}
-
-		// Parse labels
-		for _, l := range node.Issue.Labels.Nodes {
-			issue.Labels = append(issue.Labels, Label{Name: l.Name, Color: l.Color})
-		}
-
-		// Parse milestone
-		if node.Issue.Milestone.Title != "" {
-			issue.Milestone = &Milestone{Title: node.Issue.Milestone.Title}
-		}
-
-		issues = append(issues, issue)
+		// Search spans repositories, so each issue names its own
+		repository, _ := node.Issue.Repository.toRepository()
+		issues = append(issues, *node.Issue.toIssue(repository.Owner, repository.Name))
 	}
 
-	return issues, pageInfo{
-		HasNextPage: gqlQuery.Search.PageInfo.HasNextPage,
-		EndCursor:   gqlQuery.Search.PageInfo.EndCursor,
-	}, nil
+	return issues, gqlQuery.Search.PageInfo, nil
 }
 
-// GetOpenIssuesByLabel fetches open issues with a specific label
+// GetOpenIssuesByLabel fetches open issues with a specific label.
+// Returns nil on error and a non-nil empty slice when no issues carry the label.
 func (c *Client) GetOpenIssuesByLabel(owner, repo, label string) ([]Issue, error) {
 	return c.getIssuesByLabelPaginated(owner, repo, label, []IssueState{IssueStateOpen})
 }
@@ -2017,8 +2574,9 @@ func (c *Client) getIssuesByLabelPaginated(owner, repo, label string, states []I
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
-	var allIssues []Issue
+	allIssues := []Issue{}
 	var cursor *string
+	guard := c.newPageGuard()
 
 	for {
 		issues, pi, err := c.getIssuesByLabelPage(owner, repo, label, states, cursor)
@@ -2027,10 +2585,14 @@ func (c *Client) getIssuesByLabelPaginated(owner, repo, label string, states []I
 		}
 		allIssues = append(allIssues, issues...)
 
-		if !pi.HasNextPage {
+		next, err := guard.next(pi)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
 			break
 		}
-		cursor = &pi.EndCursor
+		cursor = next
 	}
 
 	return allIssues, nil
@@ -2042,21 +2604,15 @@ func (c *Client) getIssuesByLabelPage(owner, repo, label string, states []IssueS
 		Repository struct {
 			Issues struct {
 				Nodes []struct {
-					ID     string
-					Number int
-					Title  string
-					State  string
-					URL    string `graphql:"url"`
+					issueSummaryFields
 					Labels struct {
 						Nodes []struct {
 							Name string
 						}
 					} `graphql:"labels(first: 10)"`
+					CreatedAt string
 				}
-				PageInfo struct {
-					HasNextPage bool
-					EndCursor   string
-				}
+				PageInfo pageInfo
 			} `graphql:"issues(first: 100, after: $cursor, states: $states, labels: [$label])"`
 		} `graphql:"repository(owner: $owner, name: $repo)"`
 	}
@@ -2083,27 +2639,19 @@ func (c *Client) getIssuesByLabelPage(owner, repo, label string, states []IssueS
 		for _, l := range node.Labels.Nodes {
 			labels = append(labels, Label{Name: l.Name})
 		}
-		issues = append(issues, Issue{
-			ID:     node.ID,
-			Number: node.Number,
-			Title:  node.Title,
-			State:  node.State,
-			URL:    node.URL,
-			Labels: labels,
-			Repository: Repository{
-				Owner: owner,
-				Name:  repo,
-			},
-		})
+		issue := node.toIssue(owner, repo)
+		issue.Labels = labels
+		if created, err := time.Parse(time.RFC3339, node.CreatedAt); err == nil {
+			issue.CreatedAt = created
+		}
+		issues = append(issues, issue)
 	}
 
-	return issues, pageInfo{
-		HasNextPage: query.Repository.Issues.PageInfo.HasNextPage,
-		EndCursor:   query.Repository.Issues.PageInfo.EndCursor,
-	}, nil
+	return issues, query.Repository.Issues.PageInfo, nil
 }
 
-// GetClosedIssuesByLabel fetches closed issues with a specific label
+// GetClosedIssuesByLabel fetches closed issues with a specific label.
+// Returns nil on error and a non-nil empty slice when no issues carry the label.
 func (c *Client) GetClosedIssuesByLabel(owner, repo, label string) ([]Issue, error) {
 	return c.getIssuesByLabelPaginated(owner, repo, label, []IssueState{IssueStateClosed})
 }
@@ -2336,6 +2884,91 @@ func (c *Client) listOrgProjects(owner string) ([]Project, error) {
 	return projects, nil
 }
 
+// OrgRepoFilter narrows ListOrgReposFiltered. Archived repositories are
+// skipped unless IncludeArchived is set; a non-empty Topics keeps only
+// repositories tagged with at least one of them.
+type OrgRepoFilter struct {
+	Topics          []string
+	IncludeArchived bool
+}
+
+// ListOrgRepos lists the non-archived repositories of an owner (organization
+// or user) as "owner/name", sorted by name
+func (c *Client) ListOrgRepos(owner string) ([]string, error) {
+	return c.ListOrgReposFiltered(owner, OrgRepoFilter{})
+}
+
+// ListOrgReposFiltered lists an owner's repositories matching filter as
+// "owner/name", sorted by name
+func (c *Client) ListOrgReposFiltered(owner string, filter OrgRepoFilter) ([]string, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	repos := []string{}
+	var cursor *string
+	guard := c.newPageGuard()
+	for {
+		var query struct {
+			RepositoryOwner struct {
+				Repositories struct {
+					Nodes []struct {
+						NameWithOwner    string
+						IsArchived       bool
+						RepositoryTopics struct {
+							Nodes []struct {
+								Topic struct {
+									Name string
+								}
+							}
+						} `graphql:"repositoryTopics(first: 20)"`
+					}
+					PageInfo pageInfo
+				} `graphql:"repositories(first: 100, after: $cursor, orderBy: {field: NAME, direction: ASC})"`
+			} `graphql:"repositoryOwner(login: $owner)"`
+		}
+		variables := map[string]interface{}{
+			"owner":  graphql.String(owner),
+			"cursor": (*graphql.String)(nil),
+		}
+		if cursor != nil {
+			variables["cursor"] = graphql.String(*cursor)
+		}
+		if err := c.gql.Query("ListOrgRepos", &query, variables); err != nil {
+			return nil, fmt.Errorf("failed to list repositories for %s: %w", owner, err)
+		}
+
+		for _, node := range query.RepositoryOwner.Repositories.Nodes {
+			if node.IsArchived && !filter.IncludeArchived {
+				continue
+			}
+			if len(filter.Topics) > 0 {
+				tagged := false
+				for _, t := range node.RepositoryTopics.Nodes {
+					for _, want := range filter.Topics {
+						if strings.EqualFold(t.Topic.Name, want) {
+							tagged = true
+						}
+					}
+				}
+				if !tagged {
+					continue
+				}
+			}
+			repos = append(repos, node.NameWithOwner)
+		}
+
+		next, err := guard.next(query.RepositoryOwner.Repositories.PageInfo)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
+			return repos, nil
+		}
+		cursor = next
+	}
+}
+
 // GetIssuesWithProjectFieldsBatch fetches multiple issues with full detail
 // (including author, milestone, labels, assignees) and project field values
 // in a single GraphQL query. Optimized for the view command's batch mode.
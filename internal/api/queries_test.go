@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	graphql "github.com/cli/shurcooL-graphql"
 )
@@ -402,6 +404,136 @@ func TestGetProject_BothFail(t *testing.T) {
 	}
 }
 
+func TestGetProjectByID_ResolvesNode(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetProjectByID" {
+				return errors.New("unexpected query")
+			}
+			projectV2 := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2")
+			projectV2.FieldByName("ID").SetString("PVT_abc")
+			projectV2.FieldByName("Number").SetInt(7)
+			projectV2.FieldByName("Title").SetString("Ops")
+			owner := projectV2.FieldByName("Owner")
+			owner.FieldByName("TypeName").SetString("Organization")
+			owner.FieldByName("Organization").FieldByName("Login").SetString("myorg")
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	project, err := client.GetProjectByID("PVT_abc")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if project.ID != "PVT_abc" || project.Number != 7 {
+		t.Errorf("Unexpected project: %+v", project)
+	}
+	if project.Owner.Type != "Organization" || project.Owner.Login != "myorg" {
+		t.Errorf("Unexpected owner: %+v", project.Owner)
+	}
+	if len(mock.queryCalls) != 1 {
+		t.Errorf("Expected only GetProjectByID query, got: %v", mock.queryCalls)
+	}
+}
+
+func TestGetProjectByID_NotAProject(t *testing.T) {
+	// A node of another type decodes to an empty ProjectV2
+	mock := &queryMockClient{}
+
+	client := NewClientWithGraphQL(mock)
+	project, err := client.GetProjectByID("I_issue")
+
+	if project != nil {
+		t.Error("Expected nil project")
+	}
+	if !errors.Is(err, ErrNotFound) || !strings.Contains(err.Error(), "project not found: I_issue") {
+		t.Errorf("Expected project not found error, got: %v", err)
+	}
+}
+
+func TestGetProject_ProjectIDOverride(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetProjectByID" {
+				return errors.New("unexpected query")
+			}
+			projectV2 := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2")
+			projectV2.FieldByName("ID").SetString("PVT_pinned")
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	client.SetProjectID("PVT_pinned")
+	project, err := client.GetProject("owner", 1)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if project.ID != "PVT_pinned" {
+		t.Errorf("Expected pinned project, got '%s'", project.ID)
+	}
+	if len(mock.queryCalls) != 1 || mock.queryCalls[0] != "GetProjectByID" {
+		t.Errorf("Expected owner/number lookup to be skipped, got: %v", mock.queryCalls)
+	}
+}
+
+// mockProjectFieldsNamed returns a mock whose project has one text field per
+// ID, all with the given name
+func mockProjectFieldsNamed(name string, ids ...string) *queryMockClient {
+	return &queryMockClient{
+		queryFunc: func(qname string, query interface{}, variables map[string]interface{}) error {
+			nodes := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").
+				FieldByName("Fields").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), len(ids), len(ids))
+			for i, id := range ids {
+				newNodes.Index(i).FieldByName("TypeName").SetString("ProjectV2Field")
+				field := newNodes.Index(i).FieldByName("ProjectV2Field")
+				field.FieldByName("ID").SetString(id)
+				field.FieldByName("Name").SetString(name)
+				field.FieldByName("DataType").SetString("TEXT")
+			}
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+}
+
+func TestGetProjectField_ResolvesID(t *testing.T) {
+	client := NewClientWithGraphQL(mockProjectFieldsNamed("Notes", "FIELD_A"))
+
+	field, err := client.GetProjectField("proj-1", "Notes")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if field.ID != "FIELD_A" {
+		t.Errorf("Expected FIELD_A, got %s", field.ID)
+	}
+}
+
+func TestGetProjectField_SharedNameIsAmbiguous(t *testing.T) {
+	client := NewClientWithGraphQL(mockProjectFieldsNamed("Notes", "FIELD_A", "FIELD_B"))
+
+	_, err := client.GetProjectField("proj-1", "Notes")
+
+	if err == nil || !strings.Contains(err.Error(), `field name "Notes" is ambiguous`) {
+		t.Errorf("Expected ambiguous field error, got: %v", err)
+	}
+}
+
+func TestGetProjectField_NotFound(t *testing.T) {
+	client := NewClientWithGraphQL(mockProjectFieldsNamed("Notes", "FIELD_A"))
+
+	_, err := client.GetProjectField("proj-1", "Sprint")
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got: %v", err)
+	}
+}
+
 // ============================================================================
 // GetRepositoryIssues State Mapping Tests
 // ============================================================================
@@ -499,6 +631,27 @@ func TestGetRepositoryIssues_QueryError(t *testing.T) {
 	}
 }
 
+func TestGetRepositoryIssues_EmptyResult(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	issues, err := client.GetRepositoryIssues("owner", "repo", "open")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if issues == nil {
+		t.Error("Expected non-nil empty issues on success")
+	}
+	if len(issues) != 0 {
+		t.Errorf("Expected empty issues, got %d", len(issues))
+	}
+}
+
 // ============================================================================
 // GetParentIssue Tests
 // ============================================================================
@@ -617,6 +770,9 @@ func TestGetSubIssues_EmptyResult(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
+	if subIssues == nil {
+		t.Error("Expected non-nil empty subIssues on success")
+	}
 	if len(subIssues) != 0 {
 		t.Errorf("Expected empty subIssues, got %d", len(subIssues))
 	}
@@ -972,6 +1128,9 @@ func TestGetProjectItems_EmptyResult(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
+	if items == nil {
+		t.Error("Expected non-nil empty items on success")
+	}
 	if len(items) != 0 {
 		t.Errorf("Expected empty items, got %d", len(items))
 	}
@@ -1021,6 +1180,68 @@ func TestListProjects_UserSucceeds(t *testing.T) {
 	}
 }
 
+// mockOrgRepos answers ListOrgRepos with acme/api (topic "service"),
+// acme/docs (archived, topic "service") and acme/web (no topics)
+func mockOrgRepos() *queryMockClient {
+	repos := []struct {
+		name     string
+		archived bool
+		topics   []string
+	}{
+		{"acme/api", false, []string{"service"}},
+		{"acme/docs", true, []string{"service"}},
+		{"acme/web", false, nil},
+	}
+	return &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "ListOrgRepos" {
+				return errors.New("unexpected query")
+			}
+			nodes := reflect.ValueOf(query).Elem().FieldByName("RepositoryOwner").FieldByName("Repositories").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), len(repos), len(repos))
+			for i, repo := range repos {
+				node := newNodes.Index(i)
+				node.FieldByName("NameWithOwner").SetString(repo.name)
+				node.FieldByName("IsArchived").SetBool(repo.archived)
+				topics := node.FieldByName("RepositoryTopics").FieldByName("Nodes")
+				newTopics := reflect.MakeSlice(topics.Type(), len(repo.topics), len(repo.topics))
+				for j, topic := range repo.topics {
+					newTopics.Index(j).FieldByName("Topic").FieldByName("Name").SetString(topic)
+				}
+				topics.Set(newTopics)
+			}
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+}
+
+func TestListOrgRepos_ExcludesArchived(t *testing.T) {
+	client := NewClientWithGraphQL(mockOrgRepos())
+
+	repos, err := client.ListOrgRepos("acme")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := []string{"acme/api", "acme/web"}; !reflect.DeepEqual(repos, want) {
+		t.Errorf("Expected %v, got %v", want, repos)
+	}
+}
+
+func TestListOrgReposFiltered_TopicsAndArchived(t *testing.T) {
+	client := NewClientWithGraphQL(mockOrgRepos())
+
+	repos, err := client.ListOrgReposFiltered("acme", OrgRepoFilter{Topics: []string{"Service"}, IncludeArchived: true})
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := []string{"acme/api", "acme/docs"}; !reflect.DeepEqual(repos, want) {
+		t.Errorf("Expected %v, got %v", want, repos)
+	}
+}
+
 func TestListProjects_UserEmptyFallsToOrg(t *testing.T) {
 	mock := &queryMockClient{
 		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
@@ -1231,6 +1452,65 @@ func TestGetProjectFields_Pagination(t *testing.T) {
 	}
 }
 
+func TestGetProjectFields_OptionColorsAndDescriptions(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			nodes := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").FieldByName("Fields").FieldByName("Nodes")
+			nodeType := nodes.Type().Elem()
+			newNodes := reflect.MakeSlice(nodes.Type(), 2, 2)
+
+			selectNode := reflect.New(nodeType).Elem()
+			selectNode.FieldByName("TypeName").SetString("ProjectV2SingleSelectField")
+			selectField := selectNode.FieldByName("ProjectV2SingleSelectField")
+			selectField.FieldByName("ID").SetString("field-1")
+			selectField.FieldByName("Name").SetString("Status")
+			selectField.FieldByName("DataType").SetString("SINGLE_SELECT")
+			options := selectField.FieldByName("Options")
+			newOptions := reflect.MakeSlice(options.Type(), 2, 2)
+			newOptions.Index(0).FieldByName("ID").SetString("opt-1")
+			newOptions.Index(0).FieldByName("Name").SetString("Done")
+			newOptions.Index(0).FieldByName("Color").SetString("GREEN")
+			newOptions.Index(0).FieldByName("Description").SetString("Shipped")
+			newOptions.Index(1).FieldByName("ID").SetString("opt-2")
+			newOptions.Index(1).FieldByName("Name").SetString("Backlog")
+			newOptions.Index(1).FieldByName("Color").SetString("GRAY")
+			options.Set(newOptions)
+			newNodes.Index(0).Set(selectNode)
+
+			textNode := reflect.New(nodeType).Elem()
+			textNode.FieldByName("TypeName").SetString("ProjectV2Field")
+			textField := textNode.FieldByName("ProjectV2Field")
+			textField.FieldByName("ID").SetString("field-2")
+			textField.FieldByName("Name").SetString("Notes")
+			textField.FieldByName("DataType").SetString("TEXT")
+			newNodes.Index(1).Set(textNode)
+
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	fields, err := client.GetProjectFields("proj-id")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(fields) != 2 {
+		t.Fatalf("Expected 2 fields, got %d", len(fields))
+	}
+	want := []FieldOption{
+		{ID: "opt-1", Name: "Done", Color: "GREEN", Description: "Shipped"},
+		{ID: "opt-2", Name: "Backlog", Color: "GRAY"},
+	}
+	if !reflect.DeepEqual(fields[0].Options, want) {
+		t.Errorf("Expected options %+v, got %+v", want, fields[0].Options)
+	}
+	if len(fields[1].Options) != 0 {
+		t.Errorf("Expected no options on a text field, got %+v", fields[1].Options)
+	}
+}
+
 // ============================================================================
 // GetIssue Tests - Improved Coverage
 // ============================================================================
@@ -1256,6 +1536,7 @@ func TestGetIssue_Success(t *testing.T) {
 				// Set milestone
 				milestone := issue.FieldByName("Milestone")
 				milestone.FieldByName("Title").SetString("v1.0")
+				milestone.FieldByName("DueOn").SetString("2026-03-15T00:00:00Z")
 			}
 			return nil
 		},
@@ -1285,11 +1566,109 @@ func TestGetIssue_Success(t *testing.T) {
 	if issue.Milestone == nil || issue.Milestone.Title != "v1.0" {
 		t.Error("Expected milestone with title 'v1.0'")
 	}
+	if issue.Milestone != nil && issue.Milestone.DueOn != "2026-03-15T00:00:00Z" {
+		t.Errorf("Expected milestone due date, got '%s'", issue.Milestone.DueOn)
+	}
 	if issue.Repository.Owner != "owner" {
 		t.Errorf("Expected repository owner 'owner', got '%s'", issue.Repository.Owner)
 	}
 }
 
+// setIssueProjectItems fills an issueProjectItems value with the given
+// project titles (numbered from 1) and next-page flag
+func setIssueProjectItems(items reflect.Value, titles []string, hasNext bool, cursor string) {
+	nodes := items.FieldByName("Nodes")
+	newNodes := reflect.MakeSlice(nodes.Type(), len(titles), len(titles))
+	for i, title := range titles {
+		project := newNodes.Index(i).FieldByName("Project")
+		project.FieldByName("ID").SetString("proj-" + title)
+		project.FieldByName("Title").SetString(title)
+		project.FieldByName("Number").SetInt(int64(i + 1))
+	}
+	nodes.Set(newNodes)
+	items.FieldByName("PageInfo").FieldByName("HasNextPage").SetBool(hasNext)
+	items.FieldByName("PageInfo").FieldByName("EndCursor").SetString(cursor)
+}
+
+func TestGetIssue_ProjectMemberships(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name == "GetIssue" {
+				issue := reflect.ValueOf(query).Elem().FieldByName("Repository").FieldByName("Issue")
+				issue.FieldByName("ID").SetString("issue-123")
+				setIssueProjectItems(issue.FieldByName("ProjectItems"), []string{"Roadmap", "Sprint Board"}, false, "")
+			}
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	issue, err := client.GetIssue("owner", "repo", 42)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := []ProjectRef{
+		{ID: "proj-Roadmap", Title: "Roadmap", Number: 1},
+		{ID: "proj-Sprint Board", Title: "Sprint Board", Number: 2},
+	}
+	if !reflect.DeepEqual(issue.ProjectMemberships, want) {
+		t.Errorf("Expected memberships %+v, got %+v", want, issue.ProjectMemberships)
+	}
+}
+
+func TestGetIssue_NoProjectMemberships(t *testing.T) {
+	mock := &queryMockClient{}
+
+	client := NewClientWithGraphQL(mock)
+	issue, err := client.GetIssue("owner", "repo", 42)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(issue.ProjectMemberships) != 0 {
+		t.Errorf("Expected no memberships, got %+v", issue.ProjectMemberships)
+	}
+	if len(mock.queryCalls) != 1 {
+		t.Errorf("Expected a single query, got %v", mock.queryCalls)
+	}
+}
+
+func TestGetIssue_ProjectMembershipsPaginate(t *testing.T) {
+	var cursors []string
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			switch name {
+			case "GetIssue":
+				issue := reflect.ValueOf(query).Elem().FieldByName("Repository").FieldByName("Issue")
+				issue.FieldByName("ID").SetString("issue-123")
+				setIssueProjectItems(issue.FieldByName("ProjectItems"), []string{"A"}, true, "c1")
+			case "GetIssueProjects":
+				cursors = append(cursors, string(variables["cursor"].(graphql.String)))
+				if variables["issueId"] != graphql.ID("issue-123") {
+					t.Errorf("Expected issue-123, got %v", variables["issueId"])
+				}
+				items := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("Issue").FieldByName("ProjectItems")
+				setIssueProjectItems(items, []string{"B"}, false, "")
+			}
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	issue, err := client.GetIssue("owner", "repo", 42)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(issue.ProjectMemberships) != 2 || issue.ProjectMemberships[0].Title != "A" || issue.ProjectMemberships[1].Title != "B" {
+		t.Errorf("Expected memberships A and B, got %+v", issue.ProjectMemberships)
+	}
+	if !reflect.DeepEqual(cursors, []string{"c1"}) {
+		t.Errorf("Expected second page fetched after c1, got %v", cursors)
+	}
+}
+
 func TestGetIssue_WithAssignees(t *testing.T) {
 	mock := &queryMockClient{
 		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
@@ -1384,6 +1763,31 @@ func TestGetIssue_WithLabels(t *testing.T) {
 	}
 }
 
+func TestGetIssue_StateReason(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name == "GetIssue" {
+				issue := reflect.ValueOf(query).Elem().FieldByName("Repository").FieldByName("Issue")
+				issue.FieldByName("ID").SetString("issue-123")
+				issue.FieldByName("Number").SetInt(1)
+				issue.FieldByName("State").SetString("CLOSED")
+				issue.FieldByName("StateReason").SetString("NOT_PLANNED")
+			}
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	issue, err := client.GetIssue("owner", "repo", 1)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if issue.StateReason != StateReasonNotPlanned {
+		t.Errorf("Expected StateReason %q, got %q", StateReasonNotPlanned, issue.StateReason)
+	}
+}
+
 func TestGetIssue_NoMilestone(t *testing.T) {
 	mock := &queryMockClient{
 		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
@@ -1412,6 +1816,172 @@ func TestGetIssue_NoMilestone(t *testing.T) {
 	}
 }
 
+// mockBatchIssues answers GetIssues by filling every aliased issue except the
+// missing numbers, which fail the way GitHub reports them, and counts requests
+func mockBatchIssues(requests *int, missing ...int) *queryMockClient {
+	return &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetIssues" {
+				return errors.New("unexpected query")
+			}
+			*requests++
+			var err error
+			repository := reflect.ValueOf(query).Elem().FieldByName("Repository")
+			for i := 0; i < repository.NumField(); i++ {
+				var alias, number int
+				fmt.Sscanf(repository.Type().Field(i).Tag.Get("graphql"), "i%d: issue(number: %d)", &alias, &number)
+				if slices.Contains(missing, number) {
+					err = fmt.Errorf("Could not resolve to an Issue with the number of %d.", number)
+					continue
+				}
+				node := repository.Field(i)
+				node.FieldByName("ID").SetString(fmt.Sprintf("ISSUE_%d", number))
+				node.FieldByName("Number").SetInt(int64(number))
+				node.FieldByName("Title").SetString(fmt.Sprintf("Issue %d", number))
+				node.FieldByName("State").SetString("OPEN")
+			}
+			return err
+		},
+	}
+}
+
+func TestGetIssues_BatchesAndSkipsMissing(t *testing.T) {
+	// ARRANGE
+	requests := 0
+	client := NewClientWithGraphQL(mockBatchIssues(&requests, 105))
+	numbers := []int{101, 102, 103, 104, 105, 106, 107, 108, 109, 110}
+
+	// ACT
+	issues, err := client.GetIssues("owner", "repo", numbers)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requests >= len(numbers) {
+		t.Errorf("Expected fewer than %d requests, got %d", len(numbers), requests)
+	}
+	if len(issues) != 9 {
+		t.Errorf("Expected 9 issues, got %d", len(issues))
+	}
+	if _, ok := issues[105]; ok {
+		t.Error("Expected missing #105 to be absent")
+	}
+	for _, n := range numbers {
+		if n == 105 {
+			continue
+		}
+		issue := issues[n]
+		if issue == nil || issue.Title != fmt.Sprintf("Issue %d", n) || issue.Repository.Name != "repo" {
+			t.Errorf("Expected issue #%d from owner/repo, got %+v", n, issue)
+		}
+	}
+}
+
+func TestGetIssues_SplitsLargeInput(t *testing.T) {
+	// ARRANGE
+	requests := 0
+	client := NewClientWithGraphQL(mockBatchIssues(&requests))
+	numbers := make([]int, issueBatchSize*2+1)
+	for i := range numbers {
+		numbers[i] = i + 1
+	}
+
+	// ACT
+	issues, err := client.GetIssues("owner", "repo", numbers)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 batches, got %d", requests)
+	}
+	if len(issues) != len(numbers) {
+		t.Errorf("Expected %d issues, got %d", len(numbers), len(issues))
+	}
+}
+
+func TestGetIssues_EmptyInput(t *testing.T) {
+	requests := 0
+	client := NewClientWithGraphQL(mockBatchIssues(&requests))
+
+	issues, err := client.GetIssues("owner", "repo", nil)
+
+	if err != nil || issues == nil || len(issues) != 0 {
+		t.Errorf("Expected an empty map, got %v, %v", issues, err)
+	}
+	if requests != 0 {
+		t.Errorf("Expected no requests, got %d", requests)
+	}
+}
+
+func TestGetIssues_OtherErrorsFail(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return errors.New("502 Bad Gateway")
+		},
+	}
+	client := NewClientWithGraphQL(mock)
+
+	_, err := client.GetIssues("owner", "repo", []int{1})
+
+	if err == nil || !strings.Contains(err.Error(), "failed to get issues from owner/repo") {
+		t.Errorf("Expected wrapped error, got: %v", err)
+	}
+}
+
+func TestGetIssueClosers_SummarizesMergeState(t *testing.T) {
+	// ARRANGE
+	// #1 was closed without a PR, #2 by a merged PR, #3 has a merged PR and
+	// one closed without merging, #4 does not exist
+	merged := map[int][]bool{1: nil, 2: {true}, 3: {true, false}}
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetIssueClosers" {
+				return errors.New("unexpected query")
+			}
+			var err error
+			repository := reflect.ValueOf(query).Elem().FieldByName("Repository")
+			for i := 0; i < repository.NumField(); i++ {
+				var alias, number int
+				fmt.Sscanf(repository.Type().Field(i).Tag.Get("graphql"), "i%d: issue(number: %d)", &alias, &number)
+				prs, ok := merged[number]
+				if !ok {
+					err = fmt.Errorf("Could not resolve to an Issue with the number of %d.", number)
+					continue
+				}
+				node := repository.Field(i)
+				node.FieldByName("Number").SetInt(int64(number))
+				nodes := node.FieldByName("ClosedByPullRequestsReferences").FieldByName("Nodes")
+				nodes.Set(reflect.MakeSlice(nodes.Type(), len(prs), len(prs)))
+				for k, m := range prs {
+					nodes.Index(k).FieldByName("Number").SetInt(int64(200 + k))
+					nodes.Index(k).FieldByName("Merged").SetBool(m)
+				}
+			}
+			return err
+		},
+	}
+	client := NewClientWithGraphQL(mock)
+
+	// ACT
+	closers, err := client.GetIssueClosers("owner", "repo", []int{1, 2, 3, 4})
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := map[int]IssueClosers{
+		1: {Number: 1},
+		2: {Number: 2, MergedPRCount: 1},
+		3: {Number: 3, MergedPRCount: 1, HasUnmergedClosers: true},
+	}
+	if !reflect.DeepEqual(closers, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, closers)
+	}
+}
+
 // ============================================================================
 // GetProjectItems Tests - Improved Coverage
 // ============================================================================
@@ -1736,8 +2306,9 @@ func TestGetProjectItems_WithFieldValues(t *testing.T) {
 	if len(items) != 1 {
 		t.Fatalf("Expected 1 item, got %d", len(items))
 	}
-	if len(items[0].FieldValues) != 2 {
-		t.Fatalf("Expected 2 field values, got %d", len(items[0].FieldValues))
+	// Status and Notes, plus the milestone and repository pseudo-fields
+	if len(items[0].FieldValues) != 4 {
+		t.Fatalf("Expected 4 field values, got %d", len(items[0].FieldValues))
 	}
 
 	// Check Status field
@@ -1759,6 +2330,209 @@ func TestGetProjectItems_WithFieldValues(t *testing.T) {
 	}
 }
 
+func TestGetProjectItems_WithNumberFieldValue(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name == "GetProjectItems" {
+				nodes := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").
+					FieldByName("Items").FieldByName("Nodes")
+				newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+				newNode := newNodes.Index(0)
+
+				newNode.FieldByName("ID").SetString("item-1")
+				content := newNode.FieldByName("Content")
+				content.FieldByName("TypeName").SetString("Issue")
+				issue := content.FieldByName("Issue")
+				issue.FieldByName("ID").SetString("issue-1")
+				issue.FieldByName("Number").SetInt(1)
+				issue.FieldByName("Repository").FieldByName("NameWithOwner").SetString("owner/repo")
+
+				fvNodes := newNode.FieldByName("FieldValues").FieldByName("Nodes")
+				newFvNodes := reflect.MakeSlice(fvNodes.Type(), 1, 1)
+				fv := newFvNodes.Index(0)
+				fv.FieldByName("TypeName").SetString("ProjectV2ItemFieldNumberValue")
+				numberValue := fv.FieldByName("ProjectV2ItemFieldNumberValue")
+				numberValue.FieldByName("Number").SetFloat(3.5)
+				numberValue.FieldByName("Field").FieldByName("ProjectV2Field").FieldByName("Name").SetString("Story Points")
+				fvNodes.Set(newFvNodes)
+
+				nodes.Set(newNodes)
+			}
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("Expected 1 item, got %d", len(items))
+	}
+	found := false
+	for _, fv := range items[0].FieldValues {
+		if fv.Field == "Story Points" && fv.Value == "3.5" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected Story Points field with value '3.5', got %+v", items[0].FieldValues)
+	}
+}
+
+// mockItemWithFieldValue returns a mock whose single project item carries the
+// field value built by setValue
+func mockItemWithFieldValue(setValue func(fv reflect.Value)) *queryMockClient {
+	return &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name == "GetProjectItems" {
+				nodes := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").
+					FieldByName("Items").FieldByName("Nodes")
+				newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+				newNode := newNodes.Index(0)
+
+				newNode.FieldByName("ID").SetString("item-1")
+				content := newNode.FieldByName("Content")
+				content.FieldByName("TypeName").SetString("Issue")
+				issue := content.FieldByName("Issue")
+				issue.FieldByName("ID").SetString("issue-1")
+				issue.FieldByName("Number").SetInt(1)
+				issue.FieldByName("Repository").FieldByName("NameWithOwner").SetString("owner/repo")
+
+				fvNodes := newNode.FieldByName("FieldValues").FieldByName("Nodes")
+				newFvNodes := reflect.MakeSlice(fvNodes.Type(), 1, 1)
+				setValue(newFvNodes.Index(0))
+				fvNodes.Set(newFvNodes)
+
+				nodes.Set(newNodes)
+			}
+			return nil
+		},
+	}
+}
+
+// findFieldValue returns the named field value on an item
+func findFieldValue(item ProjectItem, field string) (FieldValue, bool) {
+	for _, fv := range item.FieldValues {
+		if fv.Field == field {
+			return fv, true
+		}
+	}
+	return FieldValue{}, false
+}
+
+func TestGetProjectItems_NumberFieldDecodesAsNumberKind(t *testing.T) {
+	// ARRANGE
+	mock := mockItemWithFieldValue(func(fv reflect.Value) {
+		fv.FieldByName("TypeName").SetString("ProjectV2ItemFieldNumberValue")
+		numberValue := fv.FieldByName("ProjectV2ItemFieldNumberValue")
+		numberValue.FieldByName("Number").SetFloat(3)
+		numberValue.FieldByName("Field").FieldByName("ProjectV2Field").FieldByName("Name").SetString("Story Points")
+	})
+	client := NewClientWithGraphQL(mock)
+
+	// ACT
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fv, ok := findFieldValue(items[0], "Story Points")
+	if !ok {
+		t.Fatalf("Expected Story Points field value, got %+v", items[0].FieldValues)
+	}
+	if fv.Kind != FieldValueNumber {
+		t.Errorf("Expected NUMBER kind, got %q", fv.Kind)
+	}
+	if n, ok := fv.AsNumber(); !ok || n != 3 {
+		t.Errorf("Expected AsNumber() = 3, true; got %v, %v", n, ok)
+	}
+	if fv.Value != "3" {
+		t.Errorf("Expected string value '3' kept for compatibility, got %q", fv.Value)
+	}
+	if _, ok := fv.AsDate(); ok {
+		t.Error("Expected AsDate() to fail on a number field")
+	}
+}
+
+func TestGetProjectItems_DateFieldDecodesAsDateKind(t *testing.T) {
+	// ARRANGE
+	mock := mockItemWithFieldValue(func(fv reflect.Value) {
+		fv.FieldByName("TypeName").SetString("ProjectV2ItemFieldDateValue")
+		dateValue := fv.FieldByName("ProjectV2ItemFieldDateValue")
+		dateValue.FieldByName("Date").SetString("2026-03-14")
+		dateValue.FieldByName("Field").FieldByName("ProjectV2Field").FieldByName("Name").SetString("Due")
+	})
+	client := NewClientWithGraphQL(mock)
+
+	// ACT
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fv, ok := findFieldValue(items[0], "Due")
+	if !ok {
+		t.Fatalf("Expected Due field value, got %+v", items[0].FieldValues)
+	}
+	if fv.Kind != FieldValueDate {
+		t.Errorf("Expected DATE kind, got %q", fv.Kind)
+	}
+	want := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+	if d, ok := fv.AsDate(); !ok || !d.Equal(want) {
+		t.Errorf("Expected AsDate() = %v, true; got %v, %v", want, d, ok)
+	}
+	if _, ok := fv.AsNumber(); ok {
+		t.Error("Expected AsNumber() to fail on a date field")
+	}
+}
+
+func TestGetProjectItems_SingleSelectAndIterationKeepIDs(t *testing.T) {
+	// ARRANGE
+	mock := mockItemWithFieldValue(func(fv reflect.Value) {
+		fv.FieldByName("TypeName").SetString("ProjectV2ItemFieldIterationValue")
+		iteration := fv.FieldByName("ProjectV2ItemFieldIterationValue")
+		iteration.FieldByName("Title").SetString("Sprint 4")
+		iteration.FieldByName("IterationID").SetString("iter-4")
+		iteration.FieldByName("StartDate").SetString("2026-04-06")
+		iteration.FieldByName("Duration").SetInt(14)
+		iteration.FieldByName("Field").FieldByName("ProjectV2IterationField").FieldByName("Name").SetString("Sprint")
+	})
+	client := NewClientWithGraphQL(mock)
+
+	// ACT
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fv, ok := findFieldValue(items[0], "Sprint")
+	if !ok {
+		t.Fatalf("Expected Sprint field value, got %+v", items[0].FieldValues)
+	}
+	if fv.Kind != FieldValueIteration || fv.Value != "Sprint 4" || fv.OptionID != "iter-4" || fv.Duration != 14 {
+		t.Errorf("Unexpected iteration value: %+v", fv)
+	}
+	if d, ok := fv.AsDate(); !ok || d.Format("2006-01-02") != "2026-04-06" {
+		t.Errorf("Expected iteration start date 2026-04-06, got %v, %v", d, ok)
+	}
+
+	// A single-select value keeps its option ID alongside the name
+	node := fieldValueNode{TypeName: "ProjectV2ItemFieldSingleSelectValue"}
+	node.ProjectV2ItemFieldSingleSelectValue.Name = "In progress"
+	node.ProjectV2ItemFieldSingleSelectValue.OptionID = "opt-2"
+	node.ProjectV2ItemFieldSingleSelectValue.Field.ProjectV2SingleSelectField.Name = "Status"
+	selected, ok := node.decode()
+	if !ok || selected.Kind != FieldValueSingleSelect || selected.Value != "In progress" || selected.OptionID != "opt-2" {
+		t.Errorf("Unexpected single-select value: %+v", selected)
+	}
+}
+
 func TestGetProjectItems_WithAssignees(t *testing.T) {
 	mock := &queryMockClient{
 		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
@@ -2159,6 +2933,66 @@ func TestGetRepositoryIssues_Pagination(t *testing.T) {
 	}
 }
 
+func TestGetRepositoryIssues_Pagination_RepeatedCursor(t *testing.T) {
+	// Every page claims there is another, but the cursor never moves
+	callCount := 0
+
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			callCount++
+			if callCount > 10 {
+				t.Fatal("pagination did not stop on a repeated cursor")
+			}
+			pageInfoField := reflect.ValueOf(query).Elem().FieldByName("Repository").FieldByName("Issues").FieldByName("PageInfo")
+			pageInfoField.FieldByName("HasNextPage").SetBool(true)
+			pageInfoField.FieldByName("EndCursor").SetString("cursor-1")
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	_, err := client.GetRepositoryIssues("owner", "repo", "open")
+
+	if !errors.Is(err, ErrPaginationStalled) {
+		t.Fatalf("Expected ErrPaginationStalled, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "pagination did not progress (possible API issue)") {
+		t.Errorf("Unexpected error message: %v", err)
+	}
+	if callCount != 2 {
+		t.Errorf("Expected to stop on the second page, got %d calls", callCount)
+	}
+}
+
+func TestGetRepositoryIssues_Pagination_PageCap(t *testing.T) {
+	// Cursors keep advancing, so only the page cap ends the loop
+	callCount := 0
+
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			callCount++
+			if callCount > 10 {
+				t.Fatal("pagination did not stop at the page cap")
+			}
+			pageInfoField := reflect.ValueOf(query).Elem().FieldByName("Repository").FieldByName("Issues").FieldByName("PageInfo")
+			pageInfoField.FieldByName("HasNextPage").SetBool(true)
+			pageInfoField.FieldByName("EndCursor").SetString(fmt.Sprintf("cursor-%d", callCount))
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	client.opts.MaxPages = 3
+	_, err := client.GetRepositoryIssues("owner", "repo", "open")
+
+	if !errors.Is(err, ErrPageLimitExceeded) {
+		t.Fatalf("Expected ErrPageLimitExceeded, got: %v", err)
+	}
+	if callCount != 3 {
+		t.Errorf("Expected 3 page fetches, got %d", callCount)
+	}
+}
+
 // ============================================================================
 // GetProjectItems Pagination Tests
 // ============================================================================
@@ -2232,34 +3066,246 @@ func TestGetProjectItems_Pagination_MultiplePages(t *testing.T) {
 					repo3.FieldByName("NameWithOwner").SetString("owner/repo")
 					newNodes.Index(0).Set(node3)
 
-					nodes.Set(newNodes)
+					nodes.Set(newNodes)
+
+					// Set pagination info - no more pages
+					pageInfoField.FieldByName("HasNextPage").SetBool(false)
+					pageInfoField.FieldByName("EndCursor").SetString("")
+				}
+			}
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if callCount != 2 {
+		t.Errorf("Expected 2 API calls for pagination, got %d", callCount)
+	}
+	if len(items) != 3 {
+		t.Fatalf("Expected 3 items from 2 pages, got %d", len(items))
+	}
+	if items[0].Issue.Number != 1 {
+		t.Errorf("Expected first issue number 1, got %d", items[0].Issue.Number)
+	}
+	if items[2].Issue.Number != 3 {
+		t.Errorf("Expected third issue number 3, got %d", items[2].Issue.Number)
+	}
+}
+
+func TestGetProjectItems_OnPageReportsCumulativeCount(t *testing.T) {
+	// ARRANGE: three pages of 2, 2 and 1 items
+	pageSizes := []int{2, 2, 1}
+	page := 0
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetProjectItems" {
+				return nil
+			}
+			items := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items")
+			nodes := items.FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), pageSizes[page], pageSizes[page])
+			for i := 0; i < pageSizes[page]; i++ {
+				node := newNodes.Index(i)
+				node.FieldByName("ID").SetString(fmt.Sprintf("item-%d-%d", page, i))
+				content := node.FieldByName("Content")
+				content.FieldByName("TypeName").SetString("Issue")
+				content.FieldByName("Issue").FieldByName("Number").SetInt(int64(page*10 + i))
+			}
+			nodes.Set(newNodes)
+			page++
+			if page < len(pageSizes) {
+				items.FieldByName("PageInfo").FieldByName("HasNextPage").SetBool(true)
+				items.FieldByName("PageInfo").FieldByName("EndCursor").SetString(fmt.Sprintf("cursor-%d", page))
+			}
+			return nil
+		},
+	}
+	var counts []int
+	filter := &ProjectItemsFilter{OnPage: func(fetched int) { counts = append(counts, fetched) }}
+
+	// ACT
+	items, err := NewClientWithGraphQL(mock).GetProjectItems("proj-id", filter)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 5 {
+		t.Errorf("Expected 5 items, got %d", len(items))
+	}
+	if want := []int{2, 4, 5}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("Expected OnPage counts %v, got %v", want, counts)
+	}
+}
+
+func TestGetProjectItems_Pagination_DuplicateAcrossPages(t *testing.T) {
+	// ARRANGE: item-2 appears on both pages; item-3 shares issue number 2 from another repo
+	callCount := 0
+
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetProjectItems" {
+				return nil
+			}
+			callCount++
+			v := reflect.ValueOf(query).Elem()
+			items := v.FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items")
+			nodes := items.FieldByName("Nodes")
+			pageInfoField := items.FieldByName("PageInfo")
+			nodeType := nodes.Type().Elem()
+
+			makeNode := func(itemID string, number int, repo string) reflect.Value {
+				n := reflect.New(nodeType).Elem()
+				n.FieldByName("ID").SetString(itemID)
+				content := n.FieldByName("Content")
+				content.FieldByName("TypeName").SetString("Issue")
+				issue := content.FieldByName("Issue")
+				issue.FieldByName("ID").SetString("issue-" + itemID)
+				issue.FieldByName("Number").SetInt(int64(number))
+				issue.FieldByName("State").SetString("OPEN")
+				issue.FieldByName("Repository").FieldByName("NameWithOwner").SetString(repo)
+				return n
+			}
+
+			var page []reflect.Value
+			if callCount == 1 {
+				page = []reflect.Value{
+					makeNode("item-1", 1, "owner/repo"),
+					makeNode("item-2", 2, "owner/repo"),
+				}
+				pageInfoField.FieldByName("HasNextPage").SetBool(true)
+				pageInfoField.FieldByName("EndCursor").SetString("cursor-page-1")
+			} else {
+				page = []reflect.Value{
+					makeNode("item-2", 2, "owner/repo"),
+					makeNode("item-3", 2, "owner/other"),
+				}
+				pageInfoField.FieldByName("HasNextPage").SetBool(false)
+			}
+
+			newNodes := reflect.MakeSlice(nodes.Type(), len(page), len(page))
+			for i, n := range page {
+				newNodes.Index(i).Set(n)
+			}
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+
+	// ACT
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 3 {
+		t.Fatalf("Expected 3 unique items, got %d", len(items))
+	}
+	wantIDs := []string{"item-1", "item-2", "item-3"}
+	for i, want := range wantIDs {
+		if items[i].ID != want {
+			t.Errorf("items[%d].ID = %q, want %q", i, items[i].ID, want)
+		}
+	}
+}
+
+func TestGetProjectItems_DecodesUpdatedAt(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			nodes := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items").FieldByName("Nodes")
+			n := reflect.New(nodes.Type().Elem()).Elem()
+			n.FieldByName("ID").SetString("item-1")
+			n.FieldByName("UpdatedAt").SetString("2026-03-04T05:06:07Z")
+			n.FieldByName("Content").FieldByName("TypeName").SetString("Issue")
+			nodes.Set(reflect.Append(nodes, n))
+			return nil
+		},
+	}
+	client := NewClientWithGraphQL(mock)
 
-					// Set pagination info - no more pages
-					pageInfoField.FieldByName("HasNextPage").SetBool(false)
-					pageInfoField.FieldByName("EndCursor").SetString("")
-				}
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 1 || !items[0].UpdatedAt.Equal(time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)) {
+		t.Errorf("Expected UpdatedAt decoded, got %+v", items)
+	}
+}
+
+func TestGetProjectItems_CreatedWindow(t *testing.T) {
+	// ARRANGE: items created on Jan 1, Feb 1, Mar 1, plus one without createdAt
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetProjectItems" {
+				return nil
+			}
+			items := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items")
+			nodes := items.FieldByName("Nodes")
+			nodeType := nodes.Type().Elem()
+
+			created := []string{"2026-01-01T00:00:00Z", "2026-02-01T00:00:00Z", "2026-03-01T00:00:00Z", ""}
+			newNodes := reflect.MakeSlice(nodes.Type(), len(created), len(created))
+			for i, at := range created {
+				n := reflect.New(nodeType).Elem()
+				n.FieldByName("ID").SetString(fmt.Sprintf("item-%d", i+1))
+				content := n.FieldByName("Content")
+				content.FieldByName("TypeName").SetString("Issue")
+				issue := content.FieldByName("Issue")
+				issue.FieldByName("Number").SetInt(int64(i + 1))
+				issue.FieldByName("CreatedAt").SetString(at)
+				newNodes.Index(i).Set(n)
 			}
+			nodes.Set(newNodes)
 			return nil
 		},
 	}
 
 	client := NewClientWithGraphQL(mock)
-	items, err := client.GetProjectItems("proj-id", nil)
 
+	// ACT: no window returns everything
+	all, err := client.GetProjectItems("proj-id", &ProjectItemsFilter{})
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
-	if callCount != 2 {
-		t.Errorf("Expected 2 API calls for pagination, got %d", callCount)
+	if len(all) != 4 {
+		t.Errorf("Expected 4 items without a window, got %d", len(all))
 	}
-	if len(items) != 3 {
-		t.Fatalf("Expected 3 items from 2 pages, got %d", len(items))
+
+	// ACT: window around February
+	filtered, err := client.GetProjectItems("proj-id", &ProjectItemsFilter{
+		CreatedAfter:  time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+		CreatedBefore: time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+	})
+
+	// ASSERT: only the February item passes; the item without createdAt is excluded
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
 	}
-	if items[0].Issue.Number != 1 {
-		t.Errorf("Expected first issue number 1, got %d", items[0].Issue.Number)
+	if len(filtered) != 1 || filtered[0].ID != "item-2" {
+		t.Fatalf("Expected only item-2, got %+v", filtered)
 	}
-	if items[2].Issue.Number != 3 {
-		t.Errorf("Expected third issue number 3, got %d", items[2].Issue.Number)
+	if !filtered[0].Issue.CreatedAt.Equal(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected CreatedAt to be decoded, got %v", filtered[0].Issue.CreatedAt)
+	}
+
+	// ACT: open-ended lower bound
+	recent, err := client.GetProjectItems("proj-id", &ProjectItemsFilter{
+		CreatedAfter: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(recent) != 2 {
+		t.Errorf("Expected 2 items after Jan 15, got %d", len(recent))
 	}
 }
 
@@ -2454,6 +3500,104 @@ func TestGetProjectItems_Pagination_ErrorOnSecondPage(t *testing.T) {
 	}
 }
 
+// mockFailingPagination serves one item per page with cursor "cursor-N" after
+// page N, failing on page failOn. It records the cursor of each request.
+func mockFailingPagination(failOn int, cursors *[]string) *queryMockClient {
+	return &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetProjectItems" {
+				return nil
+			}
+			cursor := ""
+			if c, ok := variables["cursor"].(graphql.String); ok {
+				cursor = string(c)
+			}
+			*cursors = append(*cursors, cursor)
+			page := len(*cursors)
+			if page == failOn {
+				return errors.New("502 Bad Gateway")
+			}
+
+			items := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items")
+			nodes := items.FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+			node := newNodes.Index(0)
+			node.FieldByName("ID").SetString(fmt.Sprintf("item-%d", page))
+			content := node.FieldByName("Content")
+			content.FieldByName("TypeName").SetString("Issue")
+			issue := content.FieldByName("Issue")
+			issue.FieldByName("Number").SetInt(int64(page))
+			issue.FieldByName("Repository").FieldByName("NameWithOwner").SetString("owner/repo")
+			nodes.Set(newNodes)
+
+			items.FieldByName("PageInfo").FieldByName("HasNextPage").SetBool(page < 4)
+			items.FieldByName("PageInfo").FieldByName("EndCursor").SetString(fmt.Sprintf("cursor-%d", page))
+			return nil
+		},
+	}
+}
+
+func TestGetProjectItems_Pagination_PartialResultOnThirdPage(t *testing.T) {
+	// ARRANGE
+	var cursors []string
+	client := NewClientWithGraphQL(mockFailingPagination(3, &cursors))
+
+	// ACT
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	// ASSERT
+	if items != nil {
+		t.Errorf("Expected nil items on error, got %d items", len(items))
+	}
+	var partial *PartialResultError[ProjectItem]
+	if !errors.As(err, &partial) {
+		t.Fatalf("Expected PartialResultError, got %T: %v", err, err)
+	}
+	if len(partial.Items) != 2 || partial.Items[0].ID != "item-1" || partial.Items[1].ID != "item-2" {
+		t.Errorf("Expected items from pages 1-2, got %+v", partial.Items)
+	}
+	if partial.Cursor != "cursor-2" {
+		t.Errorf("Expected resume cursor 'cursor-2', got %q", partial.Cursor)
+	}
+	if !strings.Contains(err.Error(), "502 Bad Gateway") {
+		t.Errorf("Expected underlying error in message, got: %v", err)
+	}
+
+	// ACT: resume from the cursor with a client that no longer fails
+	cursors = nil
+	resumed, err := NewClientWithGraphQL(mockFailingPagination(0, &cursors)).
+		GetProjectItems("proj-id", &ProjectItemsFilter{After: partial.Cursor})
+
+	// ASSERT: the resumed fetch starts after page 2
+	if err != nil {
+		t.Fatalf("Unexpected error resuming: %v", err)
+	}
+	if len(cursors) == 0 || cursors[0] != "cursor-2" {
+		t.Errorf("Expected resume to request after 'cursor-2', got %v", cursors)
+	}
+	if len(resumed) == 0 {
+		t.Error("Expected items from the resumed fetch")
+	}
+}
+
+func TestGetProjectItems_Pagination_FirstPageErrorHasNoPartial(t *testing.T) {
+	// ARRANGE
+	var cursors []string
+	client := NewClientWithGraphQL(mockFailingPagination(1, &cursors))
+
+	// ACT
+	_, err := client.GetProjectItems("proj-id", nil)
+
+	// ASSERT
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	var partial *PartialResultError[ProjectItem]
+	if errors.As(err, &partial) {
+		t.Errorf("Expected a plain error for a first-page failure, got partial %+v", partial)
+	}
+}
+
 func TestGetProjectItems_Pagination_WithFilter(t *testing.T) {
 	callCount := 0
 
@@ -2671,6 +3815,9 @@ func TestSearchRepositoryIssues_EmptyResult(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
+	if issues == nil {
+		t.Error("Expected non-nil empty issues on success")
+	}
 	if len(issues) != 0 {
 		t.Errorf("Expected empty issues, got %d", len(issues))
 	}
@@ -2868,12 +4015,14 @@ func TestGetProjectItems_WithLimit_Zero(t *testing.T) {
 				newNodes := reflect.MakeSlice(nodes.Type(), 2, 2)
 				for i := 0; i < 2; i++ {
 					newNode := reflect.New(nodeType).Elem()
-					newNode.FieldByName("ID").SetString("item-" + string(rune('0'+i)))
+					// Distinct IDs per page so each page contributes unique items
+					id := (callCount-1)*2 + i
+					newNode.FieldByName("ID").SetString("item-" + string(rune('0'+id)))
 					content := newNode.FieldByName("Content")
 					content.FieldByName("TypeName").SetString("Issue")
 					issue := content.FieldByName("Issue")
-					issue.FieldByName("ID").SetString("issue-" + string(rune('0'+i)))
-					issue.FieldByName("Number").SetInt(int64(i + 1))
+					issue.FieldByName("ID").SetString("issue-" + string(rune('0'+id)))
+					issue.FieldByName("Number").SetInt(int64(id + 1))
 					issue.FieldByName("Title").SetString("Issue")
 					issue.FieldByName("State").SetString("OPEN")
 					repo := issue.FieldByName("Repository")
@@ -2910,6 +4059,90 @@ func TestGetProjectItems_WithLimit_Zero(t *testing.T) {
 	}
 }
 
+// mockUnsortedItems returns two pages of issues out of number, title and
+// creation order: #3 "beta", #1 "Charlie", then #2 "alpha"
+func mockUnsortedItems() *queryMockClient {
+	pages := [][]struct {
+		number  int
+		title   string
+		created string
+	}{
+		{{3, "beta", "2024-01-02T00:00:00Z"}, {1, "Charlie", "2024-01-03T00:00:00Z"}},
+		{{2, "alpha", "2024-01-01T00:00:00Z"}},
+	}
+	call := 0
+	return &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetProjectItems" {
+				return nil
+			}
+			page := pages[call]
+			call++
+			items := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items")
+			nodes := items.FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), len(page), len(page))
+			for i, p := range page {
+				node := newNodes.Index(i)
+				node.FieldByName("ID").SetString(fmt.Sprintf("item-%d", p.number))
+				content := node.FieldByName("Content")
+				content.FieldByName("TypeName").SetString("Issue")
+				issue := content.FieldByName("Issue")
+				issue.FieldByName("Number").SetInt(int64(p.number))
+				issue.FieldByName("Title").SetString(p.title)
+				issue.FieldByName("CreatedAt").SetString(p.created)
+				issue.FieldByName("Repository").FieldByName("NameWithOwner").SetString("owner/repo")
+			}
+			nodes.Set(newNodes)
+			items.FieldByName("PageInfo").FieldByName("HasNextPage").SetBool(call < len(pages))
+			items.FieldByName("PageInfo").FieldByName("EndCursor").SetString(fmt.Sprintf("cursor-%d", call))
+			return nil
+		},
+	}
+}
+
+func itemNumbers(items []ProjectItem) []int {
+	numbers := make([]int, len(items))
+	for i, item := range items {
+		numbers[i] = item.Issue.Number
+	}
+	return numbers
+}
+
+func TestGetProjectItems_OrderBy(t *testing.T) {
+	tests := []struct {
+		orderBy string
+		limit   int
+		want    []int
+	}{
+		{"", 0, []int{3, 1, 2}},
+		{OrderByPosition, 0, []int{3, 1, 2}},
+		{OrderByNumber, 0, []int{1, 2, 3}},
+		{OrderByTitle, 0, []int{2, 3, 1}},
+		{OrderByCreated, 0, []int{2, 3, 1}},
+		// The limit applies after sorting, so #2 from the second page is kept
+		{OrderByNumber, 2, []int{1, 2}},
+		{"priority", 0, []int{3, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/limit=%d", tt.orderBy, tt.limit), func(t *testing.T) {
+			// ARRANGE
+			client := NewClientWithGraphQL(mockUnsortedItems())
+
+			// ACT
+			items, err := client.GetProjectItems("proj-id", &ProjectItemsFilter{OrderBy: tt.orderBy, Limit: tt.limit})
+
+			// ASSERT
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got := itemNumbers(items); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected order %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
 // ============================================================================
 // GetProjectItemsMinimal Tests
 // ============================================================================
@@ -2948,6 +4181,9 @@ func TestGetProjectItemsMinimal_EmptyResult(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
+	if items == nil {
+		t.Error("Expected non-nil empty items on success")
+	}
 	if len(items) != 0 {
 		t.Errorf("Expected empty items, got %d", len(items))
 	}
@@ -3022,14 +4258,88 @@ func TestGetProjectItemsMinimal_WithItems(t *testing.T) {
 	if items[0].Repository != "owner/repo" {
 		t.Errorf("Expected repository owner/repo, got %s", items[0].Repository)
 	}
-	if len(items[0].FieldValues) != 1 {
-		t.Fatalf("Expected 1 field value, got %d", len(items[0].FieldValues))
+	// Branch, plus the milestone and repository pseudo-fields
+	if len(items[0].FieldValues) != 3 {
+		t.Fatalf("Expected 3 field values, got %d", len(items[0].FieldValues))
 	}
 	if items[0].FieldValues[0].Field != "Branch" {
 		t.Errorf("Expected field name Branch, got %s", items[0].FieldValues[0].Field)
 	}
 }
 
+func TestGetProjectItems_PseudoFieldValues(t *testing.T) {
+	// ARRANGE: one issue with a milestone, one without
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetProjectItems" {
+				return nil
+			}
+			v := reflect.ValueOf(query).Elem()
+			items := v.FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items")
+			nodes := items.FieldByName("Nodes")
+			nodeType := nodes.Type().Elem()
+
+			newNodes := reflect.MakeSlice(nodes.Type(), 2, 2)
+			for i, milestone := range []string{"Sprint 3", ""} {
+				n := reflect.New(nodeType).Elem()
+				n.FieldByName("ID").SetString(fmt.Sprintf("item-%d", i))
+				content := n.FieldByName("Content")
+				content.FieldByName("TypeName").SetString("Issue")
+				issue := content.FieldByName("Issue")
+				issue.FieldByName("Number").SetInt(int64(i + 1))
+				issue.FieldByName("Repository").FieldByName("NameWithOwner").SetString("owner/repo")
+				issue.FieldByName("Milestone").FieldByName("Title").SetString(milestone)
+				newNodes.Index(i).Set(n)
+			}
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+	client := NewClientWithGraphQL(mock)
+
+	// ACT
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(items))
+	}
+
+	valueOf := func(item ProjectItem, field string) (string, bool) {
+		for _, fv := range item.FieldValues {
+			if fv.Field == field {
+				return fv.Value, true
+			}
+		}
+		return "", false
+	}
+
+	if got, ok := valueOf(items[0], MilestoneFieldName); !ok || got != "Sprint 3" {
+		t.Errorf("Expected __milestone 'Sprint 3', got %q (present=%v)", got, ok)
+	}
+	if got, ok := valueOf(items[0], RepositoryFieldName); !ok || got != "owner/repo" {
+		t.Errorf("Expected __repository 'owner/repo', got %q (present=%v)", got, ok)
+	}
+	if items[0].Issue.Milestone == nil || items[0].Issue.Milestone.Title != "Sprint 3" {
+		t.Errorf("Expected issue milestone 'Sprint 3', got %+v", items[0].Issue.Milestone)
+	}
+	if got, ok := valueOf(items[1], MilestoneFieldName); !ok || got != "" {
+		t.Errorf("Expected empty __milestone for issue without milestone, got %q (present=%v)", got, ok)
+	}
+}
+
+func TestIsPseudoField(t *testing.T) {
+	if !IsPseudoField(MilestoneFieldName) || !IsPseudoField(RepositoryFieldName) {
+		t.Error("Expected milestone and repository pseudo-fields to be recognised")
+	}
+	if IsPseudoField("Status") || IsPseudoField("_private") {
+		t.Error("Expected ordinary field names not to be pseudo-fields")
+	}
+}
+
 func TestGetProjectItemsMinimal_WithFilter(t *testing.T) {
 	mock := &queryMockClient{
 		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
@@ -3639,3 +4949,221 @@ func TestParseParentIssueBatchResponse_EmptyInput(t *testing.T) {
 		t.Errorf("expected empty map, got %d entries", len(result))
 	}
 }
+
+func TestGetIssuesByLabel_EmptyResult(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+
+	open, err := client.GetOpenIssuesByLabel("owner", "repo", "bug")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if open == nil || len(open) != 0 {
+		t.Errorf("Expected non-nil empty open issues, got %v", open)
+	}
+
+	closed, err := client.GetClosedIssuesByLabel("owner", "repo", "bug")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if closed == nil || len(closed) != 0 {
+		t.Errorf("Expected non-nil empty closed issues, got %v", closed)
+	}
+}
+
+func TestGetIssuesByLabel_QueryError_ReturnsNil(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return errors.New("query failed")
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	issues, err := client.GetOpenIssuesByLabel("owner", "repo", "bug")
+
+	if err == nil {
+		t.Fatal("Expected error when query fails")
+	}
+	if issues != nil {
+		t.Error("Expected nil issues when error occurs")
+	}
+}
+
+func TestGetProjectItems_IncludeArchived(t *testing.T) {
+	// ARRANGE: the default connection returns item-1, the is:archived
+	// search returns the archived item-2
+	var queries []string
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			queries = append(queries, name)
+			nodes := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").
+				FieldByName("Items").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+			n := newNodes.Index(0)
+			number := 1
+			if name == "GetArchivedProjectItems" {
+				number = 2
+				n.FieldByName("IsArchived").SetBool(true)
+			}
+			n.FieldByName("ID").SetString(fmt.Sprintf("item-%d", number))
+			content := n.FieldByName("Content")
+			content.FieldByName("TypeName").SetString("Issue")
+			content.FieldByName("Issue").FieldByName("Number").SetInt(int64(number))
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+
+	// ACT: default filter and nil filter send only the unchanged query
+	for _, filter := range []*ProjectItemsFilter{nil, {}} {
+		queries = nil
+		items, err := client.GetProjectItems("proj-id", filter)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(queries, []string{"GetProjectItems"}) {
+			t.Errorf("Expected only GetProjectItems, got %v", queries)
+		}
+		if len(items) != 1 || items[0].ID != "item-1" || items[0].Archived {
+			t.Errorf("Expected only unarchived item-1, got %+v", items)
+		}
+	}
+
+	// ACT: IncludeArchived also fetches the archived items
+	queries = nil
+	items, err := client.GetProjectItems("proj-id", &ProjectItemsFilter{IncludeArchived: true})
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(queries, []string{"GetProjectItems", "GetArchivedProjectItems"}) {
+		t.Errorf("Expected the default and archived queries, got %v", queries)
+	}
+	if len(items) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(items))
+	}
+	if items[0].Archived || !items[1].Archived || items[1].ID != "item-2" {
+		t.Errorf("Expected only item-2 flagged archived, got %+v", items)
+	}
+}
+
+func TestGetProjectItemsPage_ArchivedSearchesArchivedItems(t *testing.T) {
+	var query interface{}
+	mock := &queryMockClient{
+		queryFunc: func(name string, q interface{}, variables map[string]interface{}) error {
+			query = q
+			return nil
+		},
+	}
+
+	if _, _, err := NewClientWithGraphQL(mock).getProjectItemsPage("proj-id", nil, true); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	field, _ := reflect.TypeOf(query).Elem().FieldByName("Node")
+	field, _ = field.Type.FieldByName("ProjectV2")
+	field, _ = field.Type.FieldByName("Items")
+	if tag := field.Tag.Get("graphql"); !strings.Contains(tag, `query: "is:archived"`) {
+		t.Errorf("Expected the is:archived search, got %s", tag)
+	}
+}
+
+// mockMixedContentItems returns an issue, a pull request, and a draft issue
+func mockMixedContentItems() *queryMockClient {
+	return &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetProjectItems" {
+				return nil
+			}
+			nodes := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").
+				FieldByName("Items").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 3, 3)
+
+			issue := newNodes.Index(0)
+			issue.FieldByName("ID").SetString("item-issue")
+			issue.FieldByName("Content").FieldByName("TypeName").SetString("Issue")
+			issue.FieldByName("Content").FieldByName("Issue").FieldByName("Number").SetInt(1)
+
+			pr := newNodes.Index(1)
+			pr.FieldByName("ID").SetString("item-pr")
+			pr.FieldByName("Content").FieldByName("TypeName").SetString("PullRequest")
+			prContent := pr.FieldByName("Content").FieldByName("PullRequest")
+			prContent.FieldByName("Number").SetInt(2)
+			prContent.FieldByName("Title").SetString("Add feature")
+			prContent.FieldByName("State").SetString("MERGED")
+			prContent.FieldByName("Repository").FieldByName("NameWithOwner").SetString("owner/repo")
+
+			draft := newNodes.Index(2)
+			draft.FieldByName("ID").SetString("item-draft")
+			draft.FieldByName("Content").FieldByName("TypeName").SetString("DraftIssue")
+			draftContent := draft.FieldByName("Content").FieldByName("DraftIssue")
+			draftContent.FieldByName("Title").SetString("Idea")
+			draftContent.FieldByName("Body").SetString("Maybe later")
+
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+}
+
+func TestGetProjectItems_ContentTypes(t *testing.T) {
+	client := NewClientWithGraphQL(mockMixedContentItems())
+
+	tests := []struct {
+		name  string
+		types []string
+		want  []string
+	}{
+		{"default is issues only", nil, []string{"item-issue"}},
+		{"pull requests only", []string{ContentTypePullRequest}, []string{"item-pr"}},
+		{"all types", []string{ContentTypeIssue, ContentTypePullRequest, ContentTypeDraftIssue}, []string{"item-issue", "item-pr", "item-draft"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := client.GetProjectItems("proj-id", &ProjectItemsFilter{ContentTypes: tt.types})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var got []string
+			for _, item := range items {
+				got = append(got, item.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGetProjectItems_DecodesPullRequestAndDraft(t *testing.T) {
+	client := NewClientWithGraphQL(mockMixedContentItems())
+
+	items, err := client.GetProjectItems("proj-id", &ProjectItemsFilter{
+		ContentTypes: []string{ContentTypePullRequest, ContentTypeDraftIssue},
+		Repository:   "owner/repo",
+	})
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(items))
+	}
+	pr := items[0]
+	if pr.Issue != nil || pr.PullRequest == nil || pr.PullRequest.Number != 2 || pr.PullRequest.State != "MERGED" ||
+		pr.PullRequest.Repository != (Repository{Owner: "owner", Name: "repo"}) {
+		t.Errorf("Expected decoded pull request #2, got %+v", pr.PullRequest)
+	}
+	draft := items[1]
+	if draft.Issue != nil || draft.DraftIssue == nil || draft.DraftIssue.Title != "Idea" || draft.DraftIssue.Body != "Maybe later" {
+		t.Errorf("Expected decoded draft issue, got %+v", draft.DraftIssue)
+	}
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// requestIDHeader is the response header GitHub uses to identify a request
+// for support tickets
+const requestIDHeader = "X-Github-Request-Id"
+
+// requestIDKey is the context key under which a call's *requestID travels to
+// requestIDTransport
+type requestIDKey struct{}
+
+// requestID holds the GitHub request ID of one GraphQL call's response. Each
+// call gets its own, so concurrent calls never see each other's IDs.
+type requestID struct {
+	mu sync.Mutex
+	id string
+}
+
+func (r *requestID) set(id string) {
+	r.mu.Lock()
+	r.id = id
+	r.mu.Unlock()
+}
+
+// ID returns the recorded request ID, or "" when the request got no response
+// or the header was missing
+func (r *requestID) ID() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.id
+}
+
+// withRequestIDHolder returns a context carrying a fresh holder for the
+// request ID of the call made with it
+func withRequestIDHolder(ctx context.Context) (context.Context, *requestID) {
+	holder := &requestID{}
+	return context.WithValue(ctx, requestIDKey{}, holder), holder
+}
+
+// requestIDTransport records the GitHub request ID of each response in the
+// holder carried by its request's context
+type requestIDTransport struct {
+	base http.RoundTripper
+}
+
+func (t *requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if holder, ok := req.Context().Value(requestIDKey{}).(*requestID); ok {
+		id := ""
+		if resp != nil {
+			id = resp.Header.Get(requestIDHeader)
+		}
+		holder.set(id)
+	}
+	return resp, err
+}
+
+// contextGraphQLClient is a GraphQLClient that can send a call with a
+// context, which is how a call's request ID holder reaches the transport
+type contextGraphQLClient interface {
+	QueryWithContext(ctx context.Context, name string, query interface{}, variables map[string]interface{}) error
+	MutateWithContext(ctx context.Context, name string, mutation interface{}, variables map[string]interface{}) error
+}
+
+// requestIDGraphQL appends the GitHub request ID to errors from the wrapped
+// client, so failures can be quoted to GitHub support. Clients that cannot
+// take a context are passed through unchanged.
+type requestIDGraphQL struct {
+	GraphQLClient
+}
+
+func (g *requestIDGraphQL) Query(name string, query interface{}, variables map[string]interface{}) error {
+	c, ok := g.GraphQLClient.(contextGraphQLClient)
+	if !ok {
+		return g.GraphQLClient.Query(name, query, variables)
+	}
+	ctx, holder := withRequestIDHolder(context.Background())
+	return withRequestID(c.QueryWithContext(ctx, name, query, variables), holder.ID())
+}
+
+func (g *requestIDGraphQL) Mutate(name string, mutation interface{}, variables map[string]interface{}) error {
+	c, ok := g.GraphQLClient.(contextGraphQLClient)
+	if !ok {
+		return g.GraphQLClient.Mutate(name, mutation, variables)
+	}
+	ctx, holder := withRequestIDHolder(context.Background())
+	return withRequestID(c.MutateWithContext(ctx, name, mutation, variables), holder.ID())
+}
+
+// withRequestID wraps err with the request ID, leaving it unchanged when
+// there is no error or no ID
+func withRequestID(err error, id string) error {
+	if err == nil || id == "" {
+		return err
+	}
+	return fmt.Errorf("%w (request id: %s)", err, id)
+}
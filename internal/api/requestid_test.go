@@ -0,0 +1,166 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	ghapi "github.com/cli/go-gh/v2/pkg/api"
+)
+
+// requestIDMockClient answers calls with err and records id as the request ID
+// of each call, the way requestIDTransport does for real requests
+type requestIDMockClient struct {
+	*queryMockClient
+	id  string
+	err error
+}
+
+func (m *requestIDMockClient) record(ctx context.Context) error {
+	if holder, ok := ctx.Value(requestIDKey{}).(*requestID); ok {
+		holder.set(m.id)
+	}
+	return m.err
+}
+
+func (m *requestIDMockClient) QueryWithContext(ctx context.Context, name string, query interface{}, variables map[string]interface{}) error {
+	return m.record(ctx)
+}
+
+func (m *requestIDMockClient) MutateWithContext(ctx context.Context, name string, mutation interface{}, variables map[string]interface{}) error {
+	return m.record(ctx)
+}
+
+func TestRequestIDGraphQL_AddsRequestIDToErrors(t *testing.T) {
+	client := NewClientWithGraphQL(&requestIDGraphQL{
+		GraphQLClient: &requestIDMockClient{id: "ABCD:1234", err: errors.New("502 Bad Gateway")},
+	})
+
+	_, err := client.GetProjectItems("proj-id", nil)
+
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if !strings.HasSuffix(err.Error(), "502 Bad Gateway (request id: ABCD:1234)") {
+		t.Errorf("Expected request ID in error, got: %v", err)
+	}
+}
+
+func TestRequestIDGraphQL_NoRequestIDLeavesErrorUnchanged(t *testing.T) {
+	wrapped := &requestIDGraphQL{
+		GraphQLClient: &requestIDMockClient{err: ErrNotFound},
+	}
+
+	err := wrapped.Query("GetProject", &struct{}{}, nil)
+
+	if err != ErrNotFound {
+		t.Errorf("Expected the original error, got: %v", err)
+	}
+}
+
+func TestRequestIDGraphQL_KeepsErrorChain(t *testing.T) {
+	wrapped := &requestIDGraphQL{
+		GraphQLClient: &requestIDMockClient{id: "ABCD:1234", err: ErrNotFound},
+	}
+
+	err := wrapped.Mutate("UpdateIssue", &struct{}{}, nil)
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected errors.Is to see ErrNotFound through the request ID, got: %v", err)
+	}
+}
+
+func TestRequestIDTransport_RecordsHeaderInRequestContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "ABCD:1234")
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	ctx, holder := withRequestIDHolder(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	resp, err := (&http.Client{Transport: &requestIDTransport{base: http.DefaultTransport}}).Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := holder.ID(); got != "ABCD:1234" {
+		t.Errorf("Expected request ID from server error response, got %q", got)
+	}
+}
+
+// pausingGraphQL holds the result of the "First" query until release closes,
+// so another request can finish in between
+type pausingGraphQL struct {
+	*ghapi.GraphQLClient
+	release chan struct{}
+}
+
+func (p *pausingGraphQL) QueryWithContext(ctx context.Context, name string, query interface{}, variables map[string]interface{}) error {
+	err := p.GraphQLClient.QueryWithContext(ctx, name, query, variables)
+	if name == "First" {
+		<-p.release
+	}
+	return err
+}
+
+func TestRequestIDGraphQL_InterleavedRequestsKeepTheirOwnIDs(t *testing.T) {
+	// ARRANGE: "First" fails with ID-1, then "Second" succeeds with ID-2
+	// before First's error is wrapped
+	firstSent := make(chan struct{})
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		if strings.Contains(string(body), "query First") {
+			defer close(firstSent)
+			return &http.Response{
+				StatusCode: http.StatusBadGateway,
+				Header:     http.Header{requestIDHeader: []string{"ID-1"}},
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+				Request:    req,
+			}, nil
+		}
+		resp := jsonResponse(`{"data":{"viewer":{"login":"octocat"}}}`)
+		resp.Header.Set(requestIDHeader, "ID-2")
+		resp.Request = req
+		return resp, nil
+	})
+	gql, err := ghapi.NewGraphQLClient(ghapi.ClientOptions{
+		AuthToken: "test-token",
+		Host:      "github.com",
+		Transport: &requestIDTransport{base: base},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	pausing := &pausingGraphQL{GraphQLClient: gql, release: make(chan struct{})}
+	wrapped := &requestIDGraphQL{GraphQLClient: pausing}
+
+	// ACT
+	var firstErr error
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var q struct{ Viewer struct{ Login string } }
+		firstErr = wrapped.Query("First", &q, nil)
+	}()
+	<-firstSent
+	var q struct{ Viewer struct{ Login string } }
+	secondErr := wrapped.Query("Second", &q, nil)
+	close(pausing.release)
+	wg.Wait()
+
+	// ASSERT
+	if secondErr != nil {
+		t.Fatalf("Expected Second to succeed, got: %v", secondErr)
+	}
+	if firstErr == nil || !strings.HasSuffix(firstErr.Error(), "(request id: ID-1)") {
+		t.Errorf("Expected First's own request ID, got: %v", firstErr)
+	}
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// singleFlightGraphQL shares one in-flight request between concurrent
+// identical queries, such as --watch redraws overlapping parallel reads.
+// Queries are identical when the name, result type and every variable match,
+// so pages fetched with different cursors never share a request. Mutations
+// always go through.
+type singleFlightGraphQL struct {
+	GraphQLClient
+	group singleflight.Group
+
+	// joined, when set, is called once a query has started or joined a
+	// flight; tests use it to hold the request until every caller is waiting
+	joined func()
+}
+
+func (g *singleFlightGraphQL) Query(name string, query interface{}, variables map[string]interface{}) error {
+	key, ok := singleFlightKey(name, query, variables)
+	if !ok {
+		return g.GraphQLClient.Query(name, query, variables)
+	}
+	leader := false
+	ch := g.group.DoChan(key, func() (interface{}, error) {
+		leader = true
+		err := g.GraphQLClient.Query(name, query, variables)
+		// Waiters decode their own copy so no slice or map is shared
+		data, marshalErr := json.Marshal(query)
+		if marshalErr != nil {
+			data = nil
+		}
+		return data, err
+	})
+	if g.joined != nil {
+		g.joined()
+	}
+	res := <-ch
+	if leader {
+		return res.Err
+	}
+	data, _ := res.Val.([]byte)
+	if data == nil {
+		if res.Err != nil {
+			return res.Err
+		}
+		// The shared result could not be encoded, so fetch it separately
+		return g.GraphQLClient.Query(name, query, variables)
+	}
+	if err := json.Unmarshal(data, query); err != nil {
+		return fmt.Errorf("failed to copy shared %s result: %w", name, err)
+	}
+	return res.Err
+}
+
+// singleFlightKey identifies a query for de-duplication. It reports false
+// when the query cannot be shared safely: a non-pointer result or variables
+// that do not encode.
+func singleFlightKey(name string, query interface{}, variables map[string]interface{}) (string, bool) {
+	t := reflect.TypeOf(query)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return "", false
+	}
+	// encoding/json sorts map keys, so equal variables encode equally
+	vars, err := json.Marshal(variables)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%s\x00%s\x00%s", name, t, vars), true
+}
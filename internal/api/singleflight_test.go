@@ -0,0 +1,150 @@
+package api
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+type singleFlightQuery struct {
+	Viewer struct {
+		Login graphql.String
+	}
+	Nodes []struct {
+		Name graphql.String
+	}
+}
+
+// newBlockingSingleFlight returns a singleFlightGraphQL whose queries are
+// counted in calls and held until all callers have started or joined a flight
+func newBlockingSingleFlight(callers int, calls *int32, err error) *singleFlightGraphQL {
+	var waiting sync.WaitGroup
+	waiting.Add(callers)
+	return &singleFlightGraphQL{
+		GraphQLClient: &mockGraphQLClient{
+			queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+				atomic.AddInt32(calls, 1)
+				waiting.Wait()
+				q := query.(*singleFlightQuery)
+				q.Viewer.Login = "octocat"
+				q.Nodes = append(q.Nodes, struct{ Name graphql.String }{"first"})
+				return err
+			},
+		},
+		joined: waiting.Done,
+	}
+}
+
+// runConcurrently runs fn once per index at the same time and returns the errors
+func runConcurrently(n int, fn func(i int) error) []error {
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = fn(i)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestSingleFlightGraphQL_SharesConcurrentIdenticalQueries(t *testing.T) {
+	// ARRANGE
+	var calls int32
+	g := newBlockingSingleFlight(2, &calls, nil)
+	results := make([]singleFlightQuery, 2)
+
+	// ACT
+	errs := runConcurrently(2, func(i int) error {
+		return g.Query("Viewer", &results[i], map[string]interface{}{"owner": graphql.String("octo")})
+	})
+
+	// ASSERT
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("Expected 1 underlying query, got %d", got)
+	}
+	for i := range results {
+		if errs[i] != nil {
+			t.Errorf("Query %d: expected no error, got %v", i, errs[i])
+		}
+		if results[i].Viewer.Login != "octocat" || len(results[i].Nodes) != 1 {
+			t.Errorf("Query %d: expected the shared result, got %+v", i, results[i])
+		}
+	}
+	// Each caller owns its copy
+	results[0].Nodes[0].Name = "changed"
+	if results[1].Nodes[0].Name != "first" {
+		t.Errorf("Expected independent results, got %q", results[1].Nodes[0].Name)
+	}
+}
+
+func TestSingleFlightGraphQL_ErrorReachesAllWaiters(t *testing.T) {
+	var calls int32
+	g := newBlockingSingleFlight(2, &calls, ErrNotFound)
+
+	errs := runConcurrently(2, func(i int) error {
+		return g.Query("Viewer", &singleFlightQuery{}, nil)
+	})
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("Expected 1 underlying query, got %d", got)
+	}
+	for i, err := range errs {
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("Query %d: expected ErrNotFound, got %v", i, err)
+		}
+	}
+}
+
+func TestSingleFlightGraphQL_DifferentCursorsAreNotShared(t *testing.T) {
+	var calls int32
+	g := newBlockingSingleFlight(2, &calls, nil)
+	cursors := []string{"page1", "page2"}
+
+	runConcurrently(2, func(i int) error {
+		cursor := graphql.String(cursors[i])
+		return g.Query("Items", &singleFlightQuery{}, map[string]interface{}{"cursor": &cursor})
+	})
+
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("Expected 2 underlying queries for different cursors, got %d", got)
+	}
+}
+
+func TestSingleFlightGraphQL_MutationsAreNotShared(t *testing.T) {
+	// Each mutation waits for the other, which only returns if both ran
+	var running sync.WaitGroup
+	running.Add(2)
+	g := &singleFlightGraphQL{GraphQLClient: &mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			running.Done()
+			done := make(chan struct{})
+			go func() {
+				running.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+				return nil
+			case <-time.After(5 * time.Second):
+				return errors.New("mutations were not run separately")
+			}
+		},
+	}}
+
+	errs := runConcurrently(2, func(i int) error {
+		return g.Mutate("AddLabel", &singleFlightQuery{}, nil)
+	})
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("Mutation %d: %v", i, err)
+		}
+	}
+}
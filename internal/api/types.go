@@ -1,5 +1,10 @@
 package api
 
+import (
+	"strings"
+	"time"
+)
+
 // IssueState represents GitHub issue state enum for GraphQL queries
 type IssueState string
 
@@ -8,6 +13,12 @@ const (
 	IssueStateClosed IssueState = "CLOSED"
 )
 
+// Issue state reasons reported by GitHub for closed issues
+const (
+	StateReasonCompleted  = "COMPLETED"
+	StateReasonNotPlanned = "NOT_PLANNED"
+)
+
 // SearchFilters contains filters for searching repository issues
 type SearchFilters struct {
 	State    string   // "open", "closed", or "all"
@@ -40,26 +51,51 @@ type ProjectField struct {
 	Options  []FieldOption // For SINGLE_SELECT fields
 }
 
-// FieldOption represents an option for a single-select field
+// FieldOption represents an option for a single-select field. Color is the
+// GitHub option color (e.g. GREEN); Description is empty when unset.
 type FieldOption struct {
-	ID    string
-	Name  string
-	Color string
+	ID          string
+	Name        string
+	Color       string
+	Description string
+}
+
+// IssueClosers summarizes the pull requests that reference an issue as
+// closing it
+type IssueClosers struct {
+	Number             int
+	MergedPRCount      int  // Closing pull requests that were merged
+	HasUnmergedClosers bool // At least one closing pull request is not merged
 }
 
 // Issue represents a GitHub issue
 type Issue struct {
-	ID         string
-	Number     int
-	Title      string
-	Body       string
-	State      string
-	URL        string
-	Repository Repository
-	Author     Actor
-	Assignees  []Actor
-	Labels     []Label
-	Milestone  *Milestone
+	ID          string
+	Number      int
+	Title       string
+	Body        string
+	State       string
+	StateReason string // Why a closed issue closed; empty for open issues and older data
+	URL         string
+	Repository  Repository
+	Author      Actor
+	Assignees   []Actor
+	Labels      []Label
+	Milestone   *Milestone
+	CreatedAt   time.Time // Zero when the query did not fetch it
+	ClosedAt    time.Time // Zero for open issues or when the query did not fetch it
+	UpdatedAt   time.Time // Zero when the query did not fetch it
+
+	// ProjectMemberships lists every project the issue is in. Only GetIssue
+	// fills it; it is empty when the issue is in no projects.
+	ProjectMemberships []ProjectRef
+}
+
+// ProjectRef identifies a project an issue belongs to
+type ProjectRef struct {
+	ID     string
+	Title  string
+	Number int
 }
 
 // Repository represents a GitHub repository
@@ -82,19 +118,93 @@ type Label struct {
 // Milestone represents a GitHub milestone
 type Milestone struct {
 	Title string
+	DueOn string // ISO-8601 due date, empty when the milestone has none
 }
 
 // ProjectItem represents an issue or PR within a project
 type ProjectItem struct {
 	ID          string
-	Issue       *Issue
+	Issue       *Issue       // Set for issue items
+	PullRequest *PullRequest // Set for pull request items
+	DraftIssue  *DraftIssue  // Set for draft issue items
 	FieldValues []FieldValue
+	Archived    bool      // Item is archived in the project
+	UpdatedAt   time.Time // Last change to the item; zero when the query did not fetch it
 }
 
-// FieldValue represents a field value on a project item
+// Project item content types, as reported by the API's __typename
+const (
+	ContentTypeIssue       = "Issue"
+	ContentTypePullRequest = "PullRequest"
+	ContentTypeDraftIssue  = "DraftIssue"
+)
+
+// PullRequest is the pull request content of a project item
+type PullRequest struct {
+	ID         string
+	Number     int
+	Title      string
+	State      string // OPEN, CLOSED, or MERGED
+	URL        string
+	Repository Repository
+}
+
+// DraftIssue is the draft issue content of a project item, which lives only
+// in the project
+type DraftIssue struct {
+	ID    string
+	Title string
+	Body  string
+}
+
+// FieldValueKind identifies the project field type a FieldValue came from
+type FieldValueKind string
+
+const (
+	FieldValueText         FieldValueKind = "TEXT"
+	FieldValueNumber       FieldValueKind = "NUMBER"
+	FieldValueDate         FieldValueKind = "DATE"
+	FieldValueSingleSelect FieldValueKind = "SINGLE_SELECT"
+	FieldValueIteration    FieldValueKind = "ITERATION"
+)
+
+// FieldValue represents a field value on a project item. Value always holds
+// the string form; the typed fields are set according to Kind.
 type FieldValue struct {
-	Field string // Field name
-	Value string // Resolved value
+	Field string         // Field name
+	Value string         // Resolved value
+	Kind  FieldValueKind // Field type, empty for pseudo-fields
+
+	Number   float64   // NUMBER value
+	Date     time.Time // DATE value, or the ITERATION start date
+	OptionID string    // SINGLE_SELECT option ID, or the ITERATION ID
+	Duration int       // ITERATION length in days
+}
+
+// AsNumber returns the value of a number field
+func (fv FieldValue) AsNumber() (float64, bool) {
+	return fv.Number, fv.Kind == FieldValueNumber
+}
+
+// AsDate returns the value of a date field, or an iteration's start date
+func (fv FieldValue) AsDate() (time.Time, bool) {
+	if fv.Kind != FieldValueDate && fv.Kind != FieldValueIteration {
+		return time.Time{}, false
+	}
+	return fv.Date, !fv.Date.IsZero()
+}
+
+// Pseudo-field names for built-in issue data decoded alongside project field
+// values, so callers can filter or group by them without extra queries. The
+// "__" prefix keeps them from colliding with real project field names.
+const (
+	MilestoneFieldName  = "__milestone"  // Milestone title, empty when unset
+	RepositoryFieldName = "__repository" // Repository as "owner/repo"
+)
+
+// IsPseudoField reports whether a field name is a synthetic pseudo-field
+func IsPseudoField(name string) bool {
+	return strings.HasPrefix(name, "__")
 }
 
 // SubIssue represents a sub-issue relationship
@@ -2,10 +2,14 @@ package config
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -14,16 +18,38 @@ import (
 
 // Config represents the .gh-pmu.yml configuration file
 type Config struct {
-	Version      string            `yaml:"version,omitempty" json:"version,omitempty"`
-	Project      Project           `yaml:"project" json:"project"`
-	Repositories []string          `yaml:"repositories" json:"repositories"`
-	Framework    string            `yaml:"framework,omitempty" json:"framework,omitempty"`
-	Defaults     Defaults          `yaml:"defaults,omitempty" json:"defaults,omitempty"`
-	Fields       map[string]Field  `yaml:"fields,omitempty" json:"fields,omitempty"`
-	Triage       map[string]Triage `yaml:"triage,omitempty" json:"triage,omitempty"`
-	Release      Release           `yaml:"release,omitempty" json:"release,omitempty"`
-	Acceptance   *Acceptance       `yaml:"acceptance,omitempty" json:"acceptance,omitempty"`
-	Metadata     *Metadata         `yaml:"metadata,omitempty" json:"metadata,omitempty"`
+	Version       string             `yaml:"version,omitempty" json:"version,omitempty"`
+	Project       Project            `yaml:"project" json:"project"`
+	Projects      map[string]Project `yaml:"projects,omitempty" json:"projects,omitempty"`
+	Repositories  []string           `yaml:"repositories" json:"repositories"`
+	Framework     string             `yaml:"framework,omitempty" json:"framework,omitempty"`
+	Defaults      Defaults           `yaml:"defaults,omitempty" json:"defaults,omitempty"`
+	Fields        map[string]Field   `yaml:"fields,omitempty" json:"fields,omitempty"`
+	Triage        map[string]Triage  `yaml:"triage,omitempty" json:"triage,omitempty"`
+	Release       Release            `yaml:"release,omitempty" json:"release,omitempty"`
+	Webhooks      *Webhooks          `yaml:"webhooks,omitempty" json:"webhooks,omitempty"`
+	Notifications *Notifications     `yaml:"notifications,omitempty" json:"notifications,omitempty"`
+	Git           *Git               `yaml:"git,omitempty" json:"git,omitempty"`
+	Templates     *Templates         `yaml:"templates,omitempty" json:"templates,omitempty"`
+	Labels        *Labels            `yaml:"labels,omitempty" json:"labels,omitempty"`
+	Categories    Categories         `yaml:"categories,omitempty" json:"categories,omitempty"`
+	Sanitize      *Sanitize          `yaml:"sanitize,omitempty" json:"sanitize,omitempty"`
+	Acceptance    *Acceptance        `yaml:"acceptance,omitempty" json:"acceptance,omitempty"`
+	Metadata      *Metadata          `yaml:"metadata,omitempty" json:"metadata,omitempty"`
+
+	// RepositoryFilter narrows the repositories an owner/* entry expands to
+	RepositoryFilter *RepositoryFilter `yaml:"repository_filter,omitempty" json:"repository_filter,omitempty"`
+
+	// defaultProject holds the original project while a named project is selected,
+	// so Save never persists the selection over the default.
+	defaultProject *Project
+
+	// repositoryPatterns holds the repositories as written, with owner/*
+	// entries, once ExpandRepositories has replaced them
+	repositoryPatterns []string
+
+	// unknownKeys lists YAML keys that matched no setting when the file was loaded
+	unknownKeys []string
 }
 
 // Project contains GitHub project configuration
@@ -38,12 +64,38 @@ type Defaults struct {
 	Priority string   `yaml:"priority,omitempty" json:"priority,omitempty"`
 	Status   string   `yaml:"status,omitempty" json:"status,omitempty"`
 	Labels   []string `yaml:"labels,omitempty" json:"labels,omitempty"`
+	// StartStatus is the status alias set on a tracker when it is started
+	StartStatus string `yaml:"start_status,omitempty" json:"start_status,omitempty"`
+	// Repo is the primary repository (owner/repo) for commands that target a
+	// single issue without --repo. It must be one of Repositories.
+	Repo string `yaml:"repo,omitempty" json:"repo,omitempty"`
 }
 
 // Field maps field aliases to GitHub project field names and values
 type Field struct {
 	Field  string            `yaml:"field" json:"field"`
 	Values map[string]string `yaml:"values,omitempty" json:"values,omitempty"`
+
+	// AutoCreateOptions creates a missing single-select option (e.g. a new
+	// release version) when an issue is set to it, instead of failing
+	AutoCreateOptions bool `yaml:"auto_create_options,omitempty" json:"auto_create_options,omitempty"`
+
+	// Semantics names the Status values commands give meaning to. Only
+	// fields.status may set it.
+	Semantics *StatusSemantics `yaml:"semantics,omitempty" json:"semantics,omitempty"`
+
+	// Transitions maps a Status value to the values an issue may move to
+	// from it. Values without an entry are unrestricted.
+	Transitions map[string][]string `yaml:"transitions,omitempty" json:"transitions,omitempty"`
+}
+
+// StatusSemantics names the Status values commands give meaning to. Entries
+// are aliases or option names; unset ones fall back to the built-in defaults.
+type StatusSemantics struct {
+	DoneValues       []string `yaml:"done_values,omitempty" json:"done_values,omitempty"`
+	InProgressValues []string `yaml:"in_progress_values,omitempty" json:"in_progress_values,omitempty"`
+	BacklogValue     string   `yaml:"backlog_value,omitempty" json:"backlog_value,omitempty"`
+	ParkingLotValue  string   `yaml:"parking_lot_value,omitempty" json:"parking_lot_value,omitempty"`
 }
 
 // Triage contains configuration for triage rules
@@ -65,6 +117,158 @@ type TriageInteractive struct {
 	Estimate bool `yaml:"estimate,omitempty" json:"estimate,omitempty"`
 }
 
+// Webhooks contains URLs notified after branch lifecycle events
+type Webhooks struct {
+	OnClose string `yaml:"on_close,omitempty" json:"on_close,omitempty"`
+}
+
+// Notifications contains chat channels told about branch start and close
+type Notifications struct {
+	Slack   *NotificationChannel `yaml:"slack,omitempty" json:"slack,omitempty"`
+	Discord *NotificationChannel `yaml:"discord,omitempty" json:"discord,omitempty"`
+}
+
+// NotificationChannel is an incoming webhook for a chat platform
+type NotificationChannel struct {
+	Webhook string `yaml:"webhook,omitempty" json:"webhook,omitempty"`
+}
+
+// Git contains preconditions for commands that write to the git worktree
+type Git struct {
+	// RequireCleanWorktree makes git-writing commands refuse a dirty worktree
+	RequireCleanWorktree bool `yaml:"require_clean_worktree,omitempty" json:"require_clean_worktree,omitempty"`
+}
+
+// Templates points at text/template files that customize generated text
+type Templates struct {
+	CloseSummary string `yaml:"close_summary,omitempty" json:"close_summary,omitempty"`
+}
+
+// Labels overrides the label names gh-pmu uses for tracker issues
+type Labels struct {
+	Branch string `yaml:"branch,omitempty" json:"branch,omitempty"`
+}
+
+// Category is a release notes section and the labels that put an issue in it
+type Category struct {
+	Name   string
+	Labels []string
+}
+
+// Categories lists release notes sections in the order they are written. In
+// the config file it is a mapping of section name to labels, e.g.
+// {Features: [enhancement], Fixes: [bug]}; order matters because an issue
+// goes in the first section one of its labels matches.
+type Categories []Category
+
+// OtherCategory is the section for issues matching no configured category
+const OtherCategory = "Other"
+
+// Categorize returns the first category with one of labels, compared without
+// case, or OtherCategory when none matches (including an issue without labels).
+func (c Categories) Categorize(labels []string) string {
+	for _, category := range c {
+		for _, want := range category.Labels {
+			for _, label := range labels {
+				if strings.EqualFold(label, want) {
+					return category.Name
+				}
+			}
+		}
+	}
+	return OtherCategory
+}
+
+// UnmarshalYAML reads the category mapping, keeping its order
+func (c *Categories) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: categories must map section names to label lists", node.Line)
+	}
+	categories := make(Categories, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		var labels []string
+		if err := node.Content[i+1].Decode(&labels); err != nil {
+			return err
+		}
+		categories = append(categories, Category{Name: node.Content[i].Value, Labels: labels})
+	}
+	*c = categories
+	return nil
+}
+
+// MarshalYAML writes the categories back as an ordered mapping
+func (c Categories) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, category := range c {
+		var labels yaml.Node
+		if err := labels.Encode(category.Labels); err != nil {
+			return nil, err
+		}
+		labels.Style = yaml.FlowStyle
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: category.Name}, &labels)
+	}
+	return node, nil
+}
+
+// UnmarshalJSON reads the category object, keeping its key order
+func (c *Categories) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return fmt.Errorf("categories must map section names to label lists")
+	}
+	var categories Categories
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		var labels []string
+		if err := dec.Decode(&labels); err != nil {
+			return err
+		}
+		categories = append(categories, Category{Name: tok.(string), Labels: labels})
+	}
+	*c = categories
+	return nil
+}
+
+// MarshalJSON writes the categories as an object in their configured order
+func (c Categories) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, category := range c {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(category.Name)
+		if err != nil {
+			return nil, err
+		}
+		labels, err := json.Marshal(category.Labels)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(labels)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// RepositoryFilter narrows the repositories an owner/* entry expands to.
+// Archived repositories are left out unless IncludeArchived is set; a
+// non-empty Topics keeps only repositories with at least one of the topics.
+type RepositoryFilter struct {
+	Topics          []string `yaml:"topics,omitempty" json:"topics,omitempty"`
+	IncludeArchived bool     `yaml:"include_archived,omitempty" json:"include_archived,omitempty"`
+}
+
+// Sanitize lists content stripped from issue bodies before they are sent
+type Sanitize struct {
+	BodyPatterns []string `yaml:"body_patterns,omitempty" json:"body_patterns,omitempty"`
+}
+
 // Metadata contains cached project metadata from GitHub API
 type Metadata struct {
 	Project ProjectMetadata `yaml:"project,omitempty" json:"project,omitempty"`
@@ -96,6 +300,12 @@ const ConfigFileName = ".gh-pmu.yml"
 // ConfigFileNameJSON is the JSON companion configuration file name
 const ConfigFileNameJSON = ".gh-pmu.json"
 
+// ProfileConfigFileName returns the configuration file name for a named
+// profile, e.g. .gh-pmu.staging.yml for "staging"
+func ProfileConfigFileName(profile string) string {
+	return ".gh-pmu." + profile + ".yml"
+}
+
 // Load reads and parses a configuration file from the given path.
 // Detects format (YAML or JSON) based on file extension.
 func Load(path string) (*Config, error) {
@@ -113,11 +323,43 @@ func Load(path string) (*Config, error) {
 		if err := yaml.Unmarshal(data, &cfg); err != nil {
 			return nil, fmt.Errorf("failed to parse config file: %w", err)
 		}
+		cfg.unknownKeys = findUnknownKeys(data)
 	}
 
 	return &cfg, nil
 }
 
+// unknownKeyRegex matches the yaml.v3 strict-decoding error for an unknown key
+var unknownKeyRegex = regexp.MustCompile(`^line (\d+): field (.+) not found in type`)
+
+// findUnknownKeys decodes data strictly and returns each key that matches no
+// setting as "key (line N)". The lenient decode in Load has already succeeded,
+// so the only errors left here are unknown keys.
+func findUnknownKeys(data []byte) []string {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var strict Config
+	var typeErr *yaml.TypeError
+	if err := dec.Decode(&strict); !errors.As(err, &typeErr) {
+		return nil
+	}
+
+	var keys []string
+	for _, msg := range typeErr.Errors {
+		if m := unknownKeyRegex.FindStringSubmatch(msg); m != nil {
+			keys = append(keys, fmt.Sprintf("%s (line %s)", m[2], m[1]))
+		}
+	}
+	return keys
+}
+
+// UnknownKeys returns the keys in the loaded YAML file that match no setting,
+// such as a misspelled "repositores", as "key (line N)" entries.
+func (c *Config) UnknownKeys() []string {
+	return c.unknownKeys
+}
+
 // LoadFromDirectory finds and loads the config file from the given directory.
 // It searches up the directory tree until it finds a .gh-pmu.yml file or
 // reaches the filesystem root.
@@ -129,6 +371,20 @@ func LoadFromDirectory(dir string) (*Config, error) {
 	return Load(configPath)
 }
 
+// LoadProfileFromDirectory finds and loads the config file for profile,
+// searching up the directory tree like LoadFromDirectory. An empty profile
+// loads the default config file.
+func LoadProfileFromDirectory(dir, profile string) (*Config, error) {
+	if profile == "" {
+		return LoadFromDirectory(dir)
+	}
+	configPath, err := FindProfileConfigFile(dir, profile)
+	if err != nil {
+		return nil, err
+	}
+	return Load(configPath)
+}
+
 // LoadFromDirectoryAndNormalize loads the config and normalizes the framework field.
 // If the framework field is empty, it sets it to "IDPF" and saves the config.
 // This ensures the config file is self-documenting about which framework is in use.
@@ -198,6 +454,33 @@ func FindConfigFile(startDir string) (string, error) {
 	return "", fmt.Errorf("no %s found in %s or any parent directory", ConfigFileName, startDir)
 }
 
+// FindProfileConfigFile searches for the config file of a named profile,
+// starting from startDir and walking up the tree. There is no JSON fallback
+// for profiles. The error names the path the search started from.
+func FindProfileConfigFile(startDir, profile string) (string, error) {
+	dir, err := filepath.Abs(startDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to get absolute path: %w", err)
+	}
+
+	name := ProfileConfigFileName(profile)
+	searchDir := dir
+	for {
+		configPath := filepath.Join(searchDir, name)
+		if _, err := os.Stat(configPath); err == nil {
+			return configPath, nil
+		}
+
+		parent := filepath.Dir(searchDir)
+		if parent == searchDir {
+			break
+		}
+		searchDir = parent
+	}
+
+	return "", fmt.Errorf("profile %q config not found: %s (or any parent directory)", profile, filepath.Join(dir, name))
+}
+
 // Validate checks that required configuration fields are present
 func (c *Config) Validate() error {
 	if c.Project.Owner == "" {
@@ -212,10 +495,100 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("at least one repository is required")
 	}
 
-	return nil
+	if c.Defaults.Repo != "" {
+		found := false
+		for _, repo := range c.Repositories {
+			if strings.EqualFold(repo, c.Defaults.Repo) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("defaults.repo %q is not one of the configured repositories", c.Defaults.Repo)
+		}
+	}
+
+	// Aliases are matched loosely, so two that normalize alike would be ambiguous
+	fieldKeys := make([]string, 0, len(c.Fields))
+	for key := range c.Fields {
+		fieldKeys = append(fieldKeys, key)
+	}
+	sort.Strings(fieldKeys)
+	for _, key := range fieldKeys {
+		aliases := make([]string, 0, len(c.Fields[key].Values))
+		for alias := range c.Fields[key].Values {
+			aliases = append(aliases, alias)
+		}
+		sort.Strings(aliases)
+		seen := make(map[string]string, len(aliases))
+		for _, alias := range aliases {
+			norm := normalizeAlias(alias)
+			if prev, ok := seen[norm]; ok {
+				return fmt.Errorf("fields.%s: aliases %q and %q both match %q", key, prev, alias, norm)
+			}
+			seen[norm] = alias
+		}
+	}
+
+	// Fields resolve by display name, so two entries targeting one field are ambiguous
+	targets := make(map[string]string, len(fieldKeys))
+	for _, key := range fieldKeys {
+		target := strings.ToLower(strings.TrimSpace(c.Fields[key].Field))
+		if target == "" {
+			continue
+		}
+		if prev, ok := targets[target]; ok {
+			return fmt.Errorf("fields '%s' and '%s' both map to '%s'", prev, key, c.Fields[prev].Field)
+		}
+		targets[target] = key
+	}
+
+	if _, err := c.BodyPatterns(); err != nil {
+		return err
+	}
+
+	// Projects V2 lets fields share a name, which makes a configured name ambiguous
+	if c.Metadata != nil {
+		nameCounts := make(map[string]int, len(c.Metadata.Fields))
+		for _, f := range c.Metadata.Fields {
+			nameCounts[f.Name]++
+		}
+		for _, key := range fieldKeys {
+			if name := c.Fields[key].Field; nameCounts[name] > 1 {
+				return fmt.Errorf("fields.%s: project has %d fields named %q", key, nameCounts[name], name)
+			}
+		}
+	}
+
+	return c.validateStatusSemantics()
+}
+
+// normalizeAlias folds case, surrounding whitespace, and the space/hyphen/
+// underscore separators so "In Progress", "in-progress" and "in_progress"
+// compare equal
+func normalizeAlias(alias string) string {
+	alias = strings.ToLower(strings.TrimSpace(alias))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(alias)
+}
+
+// lookupAlias finds alias in values, trying an exact key match before a
+// normalized one. The configured value is returned verbatim.
+func lookupAlias(values map[string]string, alias string) (string, bool) {
+	if actual, ok := values[alias]; ok {
+		return actual, true
+	}
+	norm := normalizeAlias(alias)
+	for key, actual := range values {
+		if normalizeAlias(key) == norm {
+			return actual, true
+		}
+	}
+	return "", false
 }
 
 // ResolveFieldValue maps an alias to its actual GitHub field value.
+// Aliases match regardless of case, surrounding whitespace, or whether words
+// are separated by spaces, hyphens, or underscores.
 // If no alias is found, returns the original value unchanged.
 func (c *Config) ResolveFieldValue(fieldKey, alias string) string {
 	field, ok := c.Fields[fieldKey]
@@ -223,7 +596,7 @@ func (c *Config) ResolveFieldValue(fieldKey, alias string) string {
 		return alias
 	}
 
-	if actual, ok := field.Values[alias]; ok {
+	if actual, ok := lookupAlias(field.Values, alias); ok {
 		return actual
 	}
 
@@ -245,12 +618,9 @@ func (c *Config) ValidateFieldValue(fieldKey, value string) error {
 		return nil
 	}
 
-	// Check if value exists in the field's values map (case-insensitive)
-	valueLower := strings.ToLower(value)
-	for alias := range field.Values {
-		if strings.ToLower(alias) == valueLower {
-			return nil
-		}
+	// Check if value exists in the field's values map (normalized)
+	if _, ok := lookupAlias(field.Values, value); ok {
+		return nil
 	}
 
 	// Value not found, build error with available values
@@ -293,9 +663,57 @@ func (c *Config) ApplyEnvOverrides() {
 	}
 }
 
+// SelectProject makes the named entry from projects the active project.
+// An empty name keeps the default project. Fields, repositories, and all other
+// settings are shared by every named project.
+func (c *Config) SelectProject(name string) error {
+	if name == "" {
+		return nil
+	}
+
+	selected, ok := c.Projects[name]
+	if !ok {
+		if len(c.Projects) == 0 {
+			return fmt.Errorf("unknown project %q: no named projects configured", name)
+		}
+		return fmt.Errorf("unknown project %q\nAvailable projects: %s", name, strings.Join(c.ProjectNames(), ", "))
+	}
+
+	if c.defaultProject == nil {
+		original := c.Project
+		c.defaultProject = &original
+	}
+	c.Project = selected
+	return nil
+}
+
+// ProjectNames returns the configured named project keys in sorted order
+func (c *Config) ProjectNames() []string {
+	names := make([]string, 0, len(c.Projects))
+	for name := range c.Projects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Save writes the configuration back to the given path and its JSON companion.
 // The JSON companion file is derived by replacing the extension with .json.
+// A project chosen with SelectProject is not persisted; the default is written.
+// Likewise owner/* repository entries are written as patterns, not expanded.
 func (c *Config) Save(path string) error {
+	if c.defaultProject != nil || c.repositoryPatterns != nil {
+		out := *c
+		if c.defaultProject != nil {
+			out.Project = *c.defaultProject
+		}
+		if c.repositoryPatterns != nil {
+			out.Repositories = c.repositoryPatterns
+		}
+		out.defaultProject, out.repositoryPatterns = nil, nil
+		return out.Save(path)
+	}
+
 	data, err := yaml.Marshal(c)
 	if err != nil {
 		return fmt.Errorf("failed to marshal config: %w", err)
@@ -354,9 +772,13 @@ func (c *Config) AddFieldMetadata(field FieldMetadata) {
 
 // Release contains release management configuration
 type Release struct {
-	Tracks    map[string]TrackConfig `yaml:"tracks,omitempty" json:"tracks,omitempty"`
-	Artifacts *ArtifactConfig        `yaml:"artifacts,omitempty" json:"artifacts,omitempty"`
-	Coverage  *CoverageConfig        `yaml:"coverage,omitempty" json:"coverage,omitempty"`
+	Tracks       map[string]TrackConfig `yaml:"tracks,omitempty" json:"tracks,omitempty"`
+	Artifacts    *ArtifactConfig        `yaml:"artifacts,omitempty" json:"artifacts,omitempty"`
+	Coverage     *CoverageConfig        `yaml:"coverage,omitempty" json:"coverage,omitempty"`
+	DoneStatuses []string               `yaml:"done_statuses,omitempty" json:"done_statuses,omitempty"`
+	Checklist    []string               `yaml:"checklist,omitempty" json:"checklist,omitempty"`
+	// RequireSignedTag makes branch close sign every tag it creates
+	RequireSignedTag bool `yaml:"require_signed_tag,omitempty" json:"require_signed_tag,omitempty"`
 }
 
 // CoverageConfig contains configuration for release coverage gates
@@ -480,6 +902,313 @@ func (c *Config) ShouldGenerateChangelog() bool {
 	return c.Release.Artifacts.Changelog
 }
 
+// DefaultBranchLabel is the label identifying branch tracker issues
+const DefaultBranchLabel = "branch"
+
+// GetBranchLabel returns the label used to create and discover branch trackers
+func (c *Config) GetBranchLabel() string {
+	if c.Labels == nil || strings.TrimSpace(c.Labels.Branch) == "" {
+		return DefaultBranchLabel
+	}
+	return strings.TrimSpace(c.Labels.Branch)
+}
+
+// BodyPatterns compiles sanitize.body_patterns. It returns nil when none are
+// configured.
+func (c *Config) BodyPatterns() ([]*regexp.Regexp, error) {
+	if c.Sanitize == nil {
+		return nil, nil
+	}
+	patterns := make([]*regexp.Regexp, 0, len(c.Sanitize.BodyPatterns))
+	for _, p := range c.Sanitize.BodyPatterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("sanitize.body_patterns: invalid pattern %q: %w", p, err)
+		}
+		patterns = append(patterns, re)
+	}
+	return patterns, nil
+}
+
+// AutoCreateOptionFields returns the project field names whose missing
+// single-select options are created on set (fields.<alias>.auto_create_options)
+func (c *Config) AutoCreateOptionFields() []string {
+	var names []string
+	for alias, f := range c.Fields {
+		if !f.AutoCreateOptions {
+			continue
+		}
+		name := f.Field
+		if name == "" {
+			name = alias
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetCloseWebhook returns the webhook URL to notify after a branch closes, or ""
+func (c *Config) GetCloseWebhook() string {
+	if c.Webhooks == nil {
+		return ""
+	}
+	return c.Webhooks.OnClose
+}
+
+// GetSlackWebhook returns the Slack incoming webhook URL, or ""
+func (c *Config) GetSlackWebhook() string {
+	if c.Notifications == nil || c.Notifications.Slack == nil {
+		return ""
+	}
+	return c.Notifications.Slack.Webhook
+}
+
+// GetDiscordWebhook returns the Discord webhook URL, or ""
+func (c *Config) GetDiscordWebhook() string {
+	if c.Notifications == nil || c.Notifications.Discord == nil {
+		return ""
+	}
+	return c.Notifications.Discord.Webhook
+}
+
+// RequiresCleanWorktree reports whether git-writing commands must start from a
+// clean worktree
+func (c *Config) RequiresCleanWorktree() bool {
+	return c.Git != nil && c.Git.RequireCleanWorktree
+}
+
+// DefaultRepository returns the primary repository (owner/repo) for commands
+// that target a single issue without --repo: defaults.repo, else the first
+// configured repository, else ""
+func (c *Config) DefaultRepository() string {
+	if c.Defaults.Repo != "" {
+		return c.Defaults.Repo
+	}
+	if len(c.Repositories) > 0 {
+		return c.Repositories[0]
+	}
+	return ""
+}
+
+// GetCloseSummaryTemplate returns the close summary template path, or ""
+func (c *Config) GetCloseSummaryTemplate() string {
+	if c.Templates == nil {
+		return ""
+	}
+	return c.Templates.CloseSummary
+}
+
+// statusSemantics returns fields.status.semantics, empty when unset
+func (c *Config) statusSemantics() StatusSemantics {
+	if sem := c.Fields["status"].Semantics; sem != nil {
+		return *sem
+	}
+	return StatusSemantics{}
+}
+
+// doneValues returns the configured done values: fields.status.semantics.
+// done_values, else its older alias release.done_statuses
+func (c *Config) doneValues() []string {
+	if entries := c.statusSemantics().DoneValues; len(entries) > 0 {
+		return c.statusValues(entries)
+	}
+	return c.Release.DoneStatuses
+}
+
+// IsDoneStatus returns whether a Status field value counts as done for branch
+// progress: one of fields.status.semantics.done_values (or
+// release.done_statuses). Matching is case-insensitive; with none configured,
+// no status counts as done and callers fall back to issue state.
+func (c *Config) IsDoneStatus(status string) bool {
+	if status == "" {
+		return false
+	}
+	for _, value := range c.doneValues() {
+		if strings.EqualFold(strings.TrimSpace(value), strings.TrimSpace(status)) {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusDoneValue returns the Status value for finished work: the first
+// configured done value, else the done alias, else "Done".
+func (c *Config) StatusDoneValue() string {
+	if values := c.doneValues(); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return c.statusValue("", "done", "Done")
+}
+
+// StatusInProgressValue returns the Status value for work that has started:
+// the first fields.status.semantics.in_progress_values entry, else the
+// in_progress alias, else "In progress".
+func (c *Config) StatusInProgressValue() string {
+	if values := c.statusValues(c.statusSemantics().InProgressValues); len(values) > 0 {
+		return values[0]
+	}
+	return c.statusValue("", "in_progress", "In progress")
+}
+
+// StatusBacklogValue returns the Status value for unscheduled work:
+// fields.status.semantics.backlog_value, else the backlog alias, else
+// "Backlog".
+func (c *Config) StatusBacklogValue() string {
+	return c.statusValue(c.statusSemantics().BacklogValue, "backlog", "Backlog")
+}
+
+// StatusParkingLotValue returns the Status value of parked work that branch
+// commands skip: fields.status.semantics.parking_lot_value, else the
+// parking_lot alias, else "Parking Lot".
+func (c *Config) StatusParkingLotValue() string {
+	return c.statusValue(c.statusSemantics().ParkingLotValue, "parking_lot", "Parking Lot")
+}
+
+// IsStatus reports whether status, an alias or option name, names the Status
+// value. Case, spaces, hyphens and underscores are not significant.
+func (c *Config) IsStatus(status, value string) bool {
+	if status == "" {
+		return false
+	}
+	return normalizeAlias(c.ResolveFieldValue("status", status)) == normalizeAlias(value)
+}
+
+// IsInProgressStatus reports whether status, an alias or option name, is one
+// of the in-progress values, or the default one when none are configured
+func (c *Config) IsInProgressStatus(status string) bool {
+	values := c.statusValues(c.statusSemantics().InProgressValues)
+	if len(values) == 0 {
+		values = []string{c.StatusInProgressValue()}
+	}
+	for _, value := range values {
+		if c.IsStatus(status, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBacklogStatus reports whether status, an alias or option name, is the
+// backlog value
+func (c *Config) IsBacklogStatus(status string) bool {
+	return c.IsStatus(status, c.StatusBacklogValue())
+}
+
+// AllowedStatusTransitions returns the Status values that
+// fields.status.transitions allows moving to from the given value. ok is
+// false when the move is unrestricted: no transitions are configured, or from
+// has no entry. Keys and entries are aliases or option names, matched
+// case-insensitively.
+func (c *Config) AllowedStatusTransitions(from string) (allowed []string, ok bool) {
+	for key, entries := range c.Fields["status"].Transitions {
+		if strings.EqualFold(c.ResolveFieldValue("status", key), strings.TrimSpace(from)) {
+			return c.statusValues(entries), true
+		}
+	}
+	return nil, false
+}
+
+// statusValue resolves a configured semantic value, falling back to the
+// value of alias and then to def
+func (c *Config) statusValue(configured, alias, def string) string {
+	if configured != "" {
+		return c.ResolveFieldValue("status", configured)
+	}
+	if value := c.Fields["status"].Values[alias]; value != "" {
+		return value
+	}
+	return def
+}
+
+// statusValues resolves each entry of a semantic value list
+func (c *Config) statusValues(entries []string) []string {
+	values := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		values = append(values, c.ResolveFieldValue("status", entry))
+	}
+	return values
+}
+
+// validateStatusSemantics checks that every semantic status value names an
+// option of the Status field in the cached metadata. Without metadata for
+// the field there is nothing to check against.
+func (c *Config) validateStatusSemantics() error {
+	keys := make([]string, 0, len(c.Fields))
+	for key := range c.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key != "status" && c.Fields[key].Semantics != nil {
+			return fmt.Errorf("fields.%s.semantics: only fields.status can set semantics", key)
+		}
+	}
+	sem := c.statusSemantics()
+	if len(sem.DoneValues) > 0 && len(c.Release.DoneStatuses) > 0 {
+		return fmt.Errorf("release.done_statuses is an older name for fields.status.semantics.done_values; set only one")
+	}
+
+	status, ok := c.Fields["status"]
+	if !ok || c.Metadata == nil {
+		return nil
+	}
+	var options []OptionMetadata
+	found := false
+	for _, f := range c.Metadata.Fields {
+		if f.Name == status.Field {
+			options, found = f.Options, true
+			break
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	check := func(key string, entries []string) error {
+		for _, value := range c.statusValues(entries) {
+			valid := false
+			for _, opt := range options {
+				if strings.EqualFold(opt.Name, value) {
+					valid = true
+					break
+				}
+			}
+			if !valid {
+				return fmt.Errorf("fields.status.%s: %q is not an option of %s", key, value, status.Field)
+			}
+		}
+		return nil
+	}
+	if err := check("semantics.done_values", sem.DoneValues); err != nil {
+		return err
+	}
+	if err := check("semantics.in_progress_values", sem.InProgressValues); err != nil {
+		return err
+	}
+	if sem.BacklogValue != "" {
+		if err := check("semantics.backlog_value", []string{sem.BacklogValue}); err != nil {
+			return err
+		}
+	}
+	if sem.ParkingLotValue != "" {
+		if err := check("semantics.parking_lot_value", []string{sem.ParkingLotValue}); err != nil {
+			return err
+		}
+	}
+	froms := make([]string, 0, len(status.Transitions))
+	for from := range status.Transitions {
+		froms = append(froms, from)
+	}
+	sort.Strings(froms)
+	for _, from := range froms {
+		if err := check("transitions", append([]string{from}, status.Transitions[from]...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // IsCoverageGateEnabled returns whether coverage gate is enabled (default: true)
 func (c *Config) IsCoverageGateEnabled() bool {
 	if c.Release.Coverage == nil || c.Release.Coverage.Enabled == nil {
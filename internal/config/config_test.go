@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -155,6 +156,43 @@ func TestValidate_ValidConfig_ReturnsNil(t *testing.T) {
 	}
 }
 
+func TestValidate_DefaultsRepoNotConfigured_ReturnsError(t *testing.T) {
+	// ARRANGE: defaults.repo names a repository outside repositories
+	cfg := &Config{
+		Project:      Project{Owner: "acme", Number: 1},
+		Repositories: []string{"acme/app", "acme/lib"},
+		Defaults:     Defaults{Repo: "acme/other"},
+	}
+
+	// ACT
+	err := cfg.Validate()
+
+	// ASSERT
+	if err == nil || !strings.Contains(err.Error(), "defaults.repo") {
+		t.Fatalf("Expected defaults.repo error, got: %v", err)
+	}
+}
+
+func TestDefaultRepository(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want string
+	}{
+		{"defaults.repo", Config{Repositories: []string{"acme/app", "acme/lib"}, Defaults: Defaults{Repo: "acme/lib"}}, "acme/lib"},
+		{"first repository", Config{Repositories: []string{"acme/app", "acme/lib"}}, "acme/app"},
+		{"no repositories", Config{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.DefaultRepository(); got != tt.want {
+				t.Errorf("DefaultRepository() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestResolveFieldValue_WithAlias_ReturnsActualValue(t *testing.T) {
 	// ARRANGE: Config with field aliases
 	cfg := &Config{
@@ -179,6 +217,123 @@ func TestResolveFieldValue_WithAlias_ReturnsActualValue(t *testing.T) {
 	}
 }
 
+func TestResolveFieldValue_NormalizedAlias_ReturnsActualValue(t *testing.T) {
+	// ARRANGE: Config with an underscored alias
+	cfg := &Config{
+		Fields: map[string]Field{
+			"status": {
+				Field: "Status",
+				Values: map[string]string{
+					"backlog":     "Backlog",
+					"in_progress": "In progress",
+				},
+			},
+		},
+	}
+
+	for _, input := range []string{"in_progress", "In Progress", "in-progress", "In_Progress", " in_progress "} {
+		// ACT: Resolve a differently-written alias
+		value := cfg.ResolveFieldValue("status", input)
+
+		// ASSERT: Returns the configured value verbatim
+		if value != "In progress" {
+			t.Errorf("ResolveFieldValue(%q) = %q, want %q", input, value, "In progress")
+		}
+	}
+}
+
+func TestValidate_AliasesNormalizingAlike_ReturnsError(t *testing.T) {
+	// ARRANGE: Two aliases that only differ by separator
+	cfg := &Config{
+		Project: Project{
+			Owner:  "rubrical-studios",
+			Number: 13,
+		},
+		Repositories: []string{"rubrical-studios/gh-pm-test"},
+		Fields: map[string]Field{
+			"status": {
+				Field: "Status",
+				Values: map[string]string{
+					"in-progress": "In progress",
+					"in_progress": "In Progress",
+				},
+			},
+		},
+	}
+
+	// ACT: Validate the config
+	err := cfg.Validate()
+
+	// ASSERT: Ambiguous aliases are rejected
+	if err == nil {
+		t.Fatal("Expected error for ambiguous aliases")
+	}
+	if !strings.Contains(err.Error(), `fields.status: aliases "in-progress" and "in_progress"`) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestValidate_FieldsSharingTarget_ReturnsError(t *testing.T) {
+	// ARRANGE: Two config keys mapping to Release, differing only in case
+	cfg := &Config{
+		Project:      Project{Owner: "owner", Number: 1},
+		Repositories: []string{"owner/repo"},
+		Fields: map[string]Field{
+			"release": {Field: "Release"},
+			"version": {Field: "release"},
+			"status":  {Field: "Status"},
+		},
+	}
+
+	// ACT: Validate
+	err := cfg.Validate()
+
+	// ASSERT: The duplicate target names both keys
+	if err == nil || err.Error() != "fields 'release' and 'version' both map to 'Release'" {
+		t.Errorf("Expected duplicate target error, got: %v", err)
+	}
+}
+
+func TestValidate_DistinctFieldTargets_Pass(t *testing.T) {
+	cfg := &Config{
+		Project:      Project{Owner: "owner", Number: 1},
+		Repositories: []string{"owner/repo"},
+		Fields: map[string]Field{
+			"release":  {Field: "Release"},
+			"status":   {Field: "Status"},
+			"priority": {Field: "Priority"},
+		},
+	}
+
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Expected distinct targets to pass, got: %v", err)
+	}
+}
+
+func TestValidate_FieldNameSharedByProjectFields_ReturnsError(t *testing.T) {
+	// ARRANGE: Two project fields named "Notes" in metadata
+	cfg := &Config{
+		Project:      Project{Owner: "owner", Number: 1},
+		Repositories: []string{"owner/repo"},
+		Fields:       map[string]Field{"notes": {Field: "Notes"}},
+		Metadata: &Metadata{Fields: []FieldMetadata{
+			{Name: "Notes", ID: "FIELD_A", DataType: "TEXT"},
+			{Name: "Notes", ID: "FIELD_B", DataType: "TEXT"},
+		}},
+	}
+
+	// ACT: Validate
+	err := cfg.Validate()
+
+	// ASSERT: The collision is reported against the config key
+	if err == nil {
+		t.Fatal("Expected error for ambiguous field name, got nil")
+	}
+	if !strings.Contains(err.Error(), `fields.notes: project has 2 fields named "Notes"`) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
 func TestResolveFieldValue_NoAlias_ReturnsOriginal(t *testing.T) {
 	// ARRANGE: Config with field aliases
 	cfg := &Config{
@@ -217,6 +372,62 @@ func TestResolveFieldValue_UnknownField_ReturnsOriginal(t *testing.T) {
 	}
 }
 
+func TestValidate_StatusSemanticValuesMustBeOptions(t *testing.T) {
+	// ARRANGE: done_values names an option the Status field does not have
+	cfg := &Config{
+		Project:      Project{Owner: "owner", Number: 1},
+		Repositories: []string{"owner/repo"},
+		Fields: map[string]Field{"status": {
+			Field:  "Status",
+			Values: map[string]string{"done": "Done"},
+			Semantics: &StatusSemantics{
+				DoneValues:      []string{"done", "Shipped"},
+				ParkingLotValue: "Icebox",
+			},
+		}},
+		Metadata: &Metadata{Fields: []FieldMetadata{
+			{Name: "Status", ID: "FIELD_S", DataType: "SINGLE_SELECT", Options: []OptionMetadata{
+				{Name: "Done"}, {Name: "Icebox"},
+			}},
+		}},
+	}
+
+	// ACT
+	err := cfg.Validate()
+
+	// ASSERT: The alias resolves; the unknown option is reported
+	if err == nil || !strings.Contains(err.Error(), `fields.status.semantics.done_values: "Shipped" is not an option of Status`) {
+		t.Fatalf("Expected unknown done value error, got: %v", err)
+	}
+	cfg.Fields["status"].Semantics.DoneValues = []string{"done"}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Expected valid semantic values to pass, got: %v", err)
+	}
+}
+
+func TestValidate_StatusSemanticsConflicts(t *testing.T) {
+	base := func() *Config {
+		return &Config{
+			Project:      Project{Owner: "owner", Number: 1},
+			Repositories: []string{"owner/repo"},
+			Fields:       map[string]Field{"status": {Field: "Status"}, "priority": {Field: "Priority"}},
+		}
+	}
+
+	otherField := base()
+	otherField.Fields["priority"] = Field{Field: "Priority", Semantics: &StatusSemantics{BacklogValue: "P2"}}
+	if err := otherField.Validate(); err == nil || !strings.Contains(err.Error(), "fields.priority.semantics: only fields.status can set semantics") {
+		t.Errorf("Expected semantics rejected outside fields.status, got: %v", err)
+	}
+
+	both := base()
+	both.Fields["status"] = Field{Field: "Status", Semantics: &StatusSemantics{DoneValues: []string{"Done"}}}
+	both.Release.DoneStatuses = []string{"Released"}
+	if err := both.Validate(); err == nil || !strings.Contains(err.Error(), "release.done_statuses is an older name for fields.status.semantics.done_values") {
+		t.Errorf("Expected conflicting done lists rejected, got: %v", err)
+	}
+}
+
 func TestValidateFieldValue_ValidAlias_ReturnsNil(t *testing.T) {
 	// ARRANGE: Config with field aliases
 	cfg := &Config{
@@ -1936,3 +2147,470 @@ func TestFindConfigFile_YAMLTakesPrecedence(t *testing.T) {
 		t.Errorf("Expected YAML to take precedence, got: %s", found)
 	}
 }
+
+// ============================================================================
+// Named Projects Tests
+// ============================================================================
+
+func namedProjectsConfig() *Config {
+	return &Config{
+		Project: Project{Owner: "default-owner", Number: 1},
+		Projects: map[string]Project{
+			"ops":     {Owner: "ops-org", Number: 7},
+			"roadmap": {Owner: "roadmap-org", Number: 12},
+		},
+		Repositories: []string{"default-owner/repo"},
+	}
+}
+
+func TestSelectProject_NamedProject_ReplacesActiveProject(t *testing.T) {
+	cfg := namedProjectsConfig()
+
+	if err := cfg.SelectProject("ops"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if cfg.Project.Owner != "ops-org" || cfg.Project.Number != 7 {
+		t.Errorf("Expected ops-org/7, got %s/%d", cfg.Project.Owner, cfg.Project.Number)
+	}
+}
+
+func TestSelectProject_EmptyName_KeepsDefault(t *testing.T) {
+	cfg := namedProjectsConfig()
+
+	if err := cfg.SelectProject(""); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if cfg.Project.Owner != "default-owner" || cfg.Project.Number != 1 {
+		t.Errorf("Expected default project, got %s/%d", cfg.Project.Owner, cfg.Project.Number)
+	}
+}
+
+func TestSelectProject_UnknownName_ListsAvailable(t *testing.T) {
+	cfg := namedProjectsConfig()
+
+	err := cfg.SelectProject("missing")
+	if err == nil {
+		t.Fatal("Expected error for unknown project")
+	}
+	if !strings.Contains(err.Error(), "ops, roadmap") {
+		t.Errorf("Expected sorted available names in error, got: %v", err)
+	}
+	if cfg.Project.Owner != "default-owner" {
+		t.Errorf("Expected project unchanged after error, got %s", cfg.Project.Owner)
+	}
+}
+
+func TestSelectProject_NoNamedProjects_ReturnsError(t *testing.T) {
+	cfg := &Config{Project: Project{Owner: "owner", Number: 1}}
+
+	err := cfg.SelectProject("ops")
+	if err == nil {
+		t.Fatal("Expected error when no named projects are configured")
+	}
+	if !strings.Contains(err.Error(), "no named projects configured") {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestSelectProject_SavePersistsDefaultProject(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, ".gh-pmu.yml")
+	cfg := namedProjectsConfig()
+
+	if err := cfg.SelectProject("roadmap"); err != nil {
+		t.Fatalf("SelectProject failed: %v", err)
+	}
+	if err := cfg.Save(configPath); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loaded.Project.Owner != "default-owner" {
+		t.Errorf("Expected default project to be saved, got %s", loaded.Project.Owner)
+	}
+	if len(loaded.Projects) != 2 {
+		t.Errorf("Expected 2 named projects to round-trip, got %d", len(loaded.Projects))
+	}
+	if cfg.Project.Owner != "roadmap-org" {
+		t.Errorf("Expected selection to remain active after Save, got %s", cfg.Project.Owner)
+	}
+}
+
+func TestIsDoneStatus(t *testing.T) {
+	cfg := &Config{Release: Release{DoneStatuses: []string{"Done", " Released "}}}
+
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{"Done", true},
+		{"done", true},
+		{"Released", true},
+		{"In progress", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := cfg.IsDoneStatus(tt.status); got != tt.want {
+			t.Errorf("IsDoneStatus(%q) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+
+	empty := &Config{}
+	if empty.IsDoneStatus("Done") {
+		t.Error("Expected no done statuses when release.done_statuses is empty")
+	}
+}
+
+func TestIsDoneStatus_StatusDoneValues(t *testing.T) {
+	cfg := &Config{Fields: map[string]Field{"status": {
+		Field:     "Status",
+		Values:    map[string]string{"shipped": "Shipped"},
+		Semantics: &StatusSemantics{DoneValues: []string{"shipped", "Won't do"}},
+	}}}
+
+	if !cfg.IsDoneStatus("Shipped") || !cfg.IsDoneStatus("won't do") {
+		t.Error("Expected fields.status.semantics.done_values to count as done")
+	}
+	if cfg.IsDoneStatus("In progress") {
+		t.Error("Expected In progress not done")
+	}
+	if got := cfg.StatusDoneValue(); got != "Shipped" {
+		t.Errorf("Expected the first done value, got %q", got)
+	}
+}
+
+func TestStatusDoneValue_FallsBack(t *testing.T) {
+	if got := (&Config{}).StatusDoneValue(); got != "Done" {
+		t.Errorf("Expected default done value, got %q", got)
+	}
+	alias := &Config{Fields: map[string]Field{"status": {Field: "Status", Values: map[string]string{"done": "✅ Done"}}}}
+	if got := alias.StatusDoneValue(); got != "✅ Done" {
+		t.Errorf("Expected done alias value, got %q", got)
+	}
+	legacy := &Config{Release: Release{DoneStatuses: []string{" Released "}}}
+	if got := legacy.StatusDoneValue(); got != "Released" {
+		t.Errorf("Expected release.done_statuses value, got %q", got)
+	}
+}
+
+func TestIsInProgressAndBacklogStatus(t *testing.T) {
+	defaults := &Config{}
+	if !defaults.IsInProgressStatus("in_progress") || !defaults.IsInProgressStatus("In Progress") || defaults.IsInProgressStatus("Ready") {
+		t.Error("Expected the default in-progress value to match its spellings only")
+	}
+	if !defaults.IsBacklogStatus("backlog") || defaults.IsBacklogStatus("") {
+		t.Error("Expected the default backlog value to match")
+	}
+
+	configured := &Config{Fields: map[string]Field{"status": {
+		Field:     "Status",
+		Values:    map[string]string{"doing": "Doing"},
+		Semantics: &StatusSemantics{InProgressValues: []string{"doing", "Reviewing"}, BacklogValue: "Inbox"},
+	}}}
+	if !configured.IsInProgressStatus("Doing") || !configured.IsInProgressStatus("reviewing") || configured.IsInProgressStatus("In progress") {
+		t.Error("Expected only the configured in-progress values to match")
+	}
+	if !configured.IsBacklogStatus("inbox") || configured.IsBacklogStatus("Backlog") {
+		t.Error("Expected only the configured backlog value to match")
+	}
+}
+
+func TestStatusSemanticValues(t *testing.T) {
+	defaults := &Config{}
+	if got := defaults.StatusInProgressValue(); got != "In progress" {
+		t.Errorf("Expected default in-progress value, got %q", got)
+	}
+	if got := defaults.StatusBacklogValue(); got != "Backlog" {
+		t.Errorf("Expected default backlog value, got %q", got)
+	}
+	if got := defaults.StatusParkingLotValue(); got != "Parking Lot" {
+		t.Errorf("Expected default parking lot value, got %q", got)
+	}
+
+	aliases := &Config{Fields: map[string]Field{"status": {Field: "Status", Values: map[string]string{
+		"in_progress": "Doing", "backlog": "Todo", "parking_lot": "🅿️ Parking Lot",
+	}}}}
+	if aliases.StatusInProgressValue() != "Doing" || aliases.StatusBacklogValue() != "Todo" || aliases.StatusParkingLotValue() != "🅿️ Parking Lot" {
+		t.Errorf("Expected alias values, got %q, %q, %q", aliases.StatusInProgressValue(), aliases.StatusBacklogValue(), aliases.StatusParkingLotValue())
+	}
+
+	configured := &Config{Fields: map[string]Field{"status": {
+		Field:  "Status",
+		Values: map[string]string{"in_progress": "Doing", "icebox": "Icebox"},
+		Semantics: &StatusSemantics{
+			InProgressValues: []string{"Active", "Doing"},
+			BacklogValue:     "Inbox",
+			ParkingLotValue:  "icebox",
+		},
+	}}}
+	if configured.StatusInProgressValue() != "Active" || configured.StatusBacklogValue() != "Inbox" || configured.StatusParkingLotValue() != "Icebox" {
+		t.Errorf("Expected configured values, got %q, %q, %q", configured.StatusInProgressValue(), configured.StatusBacklogValue(), configured.StatusParkingLotValue())
+	}
+}
+
+func TestAllowedStatusTransitions(t *testing.T) {
+	cfg := &Config{Fields: map[string]Field{"status": {
+		Field:       "Status",
+		Values:      map[string]string{"backlog": "Backlog", "in_progress": "In Progress"},
+		Transitions: map[string][]string{"backlog": {"in_progress"}, "Done": {}},
+	}}}
+
+	allowed, ok := cfg.AllowedStatusTransitions("backlog")
+	if !ok || len(allowed) != 1 || allowed[0] != "In Progress" {
+		t.Errorf("Expected [In Progress] from Backlog, got %v (ok=%v)", allowed, ok)
+	}
+	if allowed, ok := cfg.AllowedStatusTransitions("Done"); !ok || len(allowed) != 0 {
+		t.Errorf("Expected no moves from Done, got %v (ok=%v)", allowed, ok)
+	}
+	if _, ok := cfg.AllowedStatusTransitions("In Progress"); ok {
+		t.Error("Expected a status without an entry to be unrestricted")
+	}
+	if _, ok := (&Config{}).AllowedStatusTransitions("Backlog"); ok {
+		t.Error("Expected no transitions to leave every status unrestricted")
+	}
+}
+
+func TestGetBranchLabel(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels *Labels
+		want   string
+	}{
+		{"no labels section", nil, "branch"},
+		{"empty branch label", &Labels{}, "branch"},
+		{"whitespace branch label", &Labels{Branch: "  "}, "branch"},
+		{"custom branch label", &Labels{Branch: " tracker "}, "tracker"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Labels: tt.labels}
+			if got := cfg.GetBranchLabel(); got != tt.want {
+				t.Errorf("GetBranchLabel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCategories_Categorize(t *testing.T) {
+	categories := Categories{
+		{Name: "Features", Labels: []string{"enhancement", "feature"}},
+		{Name: "Fixes", Labels: []string{"bug"}},
+		{Name: "Chores", Labels: []string{"chore", "docs"}},
+	}
+	tests := []struct {
+		name   string
+		labels []string
+		want   string
+	}{
+		{"single match", []string{"bug"}, "Fixes"},
+		{"case-insensitive", []string{"Docs"}, "Chores"},
+		{"first listed category wins", []string{"docs", "bug", "feature"}, "Features"},
+		{"unmatched labels", []string{"question"}, "Other"},
+		{"no labels", nil, "Other"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := categories.Categorize(tt.labels); got != tt.want {
+				t.Errorf("Categorize(%v) = %q, want %q", tt.labels, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoad_Categories_KeepsOrderThroughSave(t *testing.T) {
+	// ARRANGE: categories written out of alphabetical order
+	tmpDir := t.TempDir()
+	yamlPath := filepath.Join(tmpDir, ".gh-pmu.yml")
+	content := "project:\n  owner: acme\n  number: 1\nrepositories: [acme/app]\n" +
+		"categories:\n  Fixes: [bug]\n  Features: [enhancement, feature]\n  Chores: [chore, docs]\n"
+	if err := os.WriteFile(yamlPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	want := Categories{
+		{Name: "Fixes", Labels: []string{"bug"}},
+		{Name: "Features", Labels: []string{"enhancement", "feature"}},
+		{Name: "Chores", Labels: []string{"chore", "docs"}},
+	}
+
+	// ACT
+	cfg, err := Load(yamlPath)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if err := cfg.Save(yamlPath); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	reloaded, err := Load(yamlPath)
+	if err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	fromJSON, err := Load(filepath.Join(tmpDir, ".gh-pmu.json"))
+	if err != nil {
+		t.Fatalf("JSON load failed: %v", err)
+	}
+
+	// ASSERT
+	for name, got := range map[string]Categories{"loaded": cfg.Categories, "reloaded": reloaded.Categories, "json": fromJSON.Categories} {
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s categories = %+v, want %+v", name, got, want)
+		}
+	}
+	if keys := cfg.UnknownKeys(); len(keys) != 0 {
+		t.Errorf("Expected categories to be a known key, got %v", keys)
+	}
+}
+
+func TestLoad_UnknownKey_ReportsKeyAndLine(t *testing.T) {
+	// ARRANGE: Config with "repositores" instead of "repositories"
+	configPath := filepath.Join("..", "..", "testdata", "config", "unknown-key.gh-pmu.yml")
+
+	// ACT: Load the configuration
+	cfg, err := Load(configPath)
+
+	// ASSERT: Loading succeeds and the typo is reported
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	keys := cfg.UnknownKeys()
+	if len(keys) != 1 || keys[0] != "repositores (line 5)" {
+		t.Errorf("Expected [repositores (line 5)], got %v", keys)
+	}
+}
+
+func TestLoad_KnownKeysOnly_ReportsNothing(t *testing.T) {
+	// ARRANGE: Path to valid test config
+	configPath := filepath.Join("..", "..", "testdata", "config", "valid.gh-pmu.yml")
+
+	// ACT: Load the configuration
+	cfg, err := Load(configPath)
+
+	// ASSERT: No unknown keys
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if keys := cfg.UnknownKeys(); len(keys) != 0 {
+		t.Errorf("Expected no unknown keys, got %v", keys)
+	}
+}
+
+func TestBodyPatterns_CompilesConfiguredPatterns(t *testing.T) {
+	// ARRANGE
+	cfg := &Config{Sanitize: &Sanitize{BodyPatterns: []string{`<!-- assistant:.*?-->`}}}
+
+	// ACT
+	patterns, err := cfg.BodyPatterns()
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(patterns) != 1 || !patterns[0].MatchString("<!-- assistant: hi -->") {
+		t.Errorf("Expected compiled pattern, got %v", patterns)
+	}
+}
+
+func TestAutoCreateOptionFields_ListsEnabledFields(t *testing.T) {
+	// ARRANGE
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".gh-pmu.yml")
+	content := `project:
+  owner: owner
+  number: 1
+repositories:
+  - owner/repo
+fields:
+  release:
+    field: Release
+    auto_create_options: true
+  status:
+    field: Status
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// ACT
+	cfg, err := Load(path)
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if keys := cfg.UnknownKeys(); len(keys) != 0 {
+		t.Errorf("Expected auto_create_options to be a known key, got %v", keys)
+	}
+	if got := cfg.AutoCreateOptionFields(); len(got) != 1 || got[0] != "Release" {
+		t.Errorf("Expected [Release], got %v", got)
+	}
+}
+
+func TestValidate_InvalidBodyPattern_ReturnsError(t *testing.T) {
+	// ARRANGE
+	cfg := &Config{
+		Project:      Project{Owner: "owner", Number: 1},
+		Repositories: []string{"owner/repo"},
+		Sanitize:     &Sanitize{BodyPatterns: []string{"("}},
+	}
+
+	// ACT
+	err := cfg.Validate()
+
+	// ASSERT
+	if err == nil || !strings.Contains(err.Error(), "sanitize.body_patterns") {
+		t.Errorf("Expected invalid pattern error, got: %v", err)
+	}
+}
+
+func TestFindProfileConfigFile_InParentDir_ReturnsPath(t *testing.T) {
+	// ARRANGE: Profile config in parent, default config alongside it
+	parentDir := t.TempDir()
+	childDir := filepath.Join(parentDir, "subdir")
+	if err := os.MkdirAll(childDir, 0755); err != nil {
+		t.Fatalf("Failed to create subdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(parentDir, ConfigFileName), []byte("project:\n  owner: test\n"), 0644); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+	profilePath := filepath.Join(parentDir, ".gh-pmu.staging.yml")
+	if err := os.WriteFile(profilePath, []byte("project:\n  owner: staging\n"), 0644); err != nil {
+		t.Fatalf("Failed to create profile config file: %v", err)
+	}
+
+	// ACT
+	found, err := FindProfileConfigFile(childDir, "staging")
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if found != profilePath {
+		t.Errorf("Expected %s, got %s", profilePath, found)
+	}
+}
+
+func TestLoadProfileFromDirectory_MissingProfile_ReturnsResolvedPath(t *testing.T) {
+	// ARRANGE: Only the default config exists
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ConfigFileName), []byte("project:\n  owner: test\n"), 0644); err != nil {
+		t.Fatalf("Failed to create config file: %v", err)
+	}
+
+	// ACT
+	_, err := LoadProfileFromDirectory(dir, "prod")
+
+	// ASSERT: No fallback to the default file
+	if err == nil {
+		t.Fatal("Expected error for missing profile config, got nil")
+	}
+	if !strings.Contains(err.Error(), filepath.Join(dir, ".gh-pmu.prod.yml")) {
+		t.Errorf("Expected resolved path in error, got: %v", err)
+	}
+}
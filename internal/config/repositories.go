@@ -0,0 +1,230 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RepoLister lists an owner's repositories as "owner/name", already narrowed
+// by the config's RepositoryFilter
+type RepoLister func(owner string) ([]string, error)
+
+// repoCacheTTL is how long a cached owner listing is used before the owner
+// is listed again
+const repoCacheTTL = time.Hour
+
+// repoCacheFile is the file in the gh-pmu cache directory that holds owner
+// listings between commands
+const repoCacheFile = "repositories.json"
+
+// repoCacheDir overrides the cache directory when set (for testing)
+var repoCacheDir string
+
+// repoCacheMu serializes this process's reads and writes of the cache file
+var repoCacheMu sync.Mutex
+
+// repoCache is the on-disk cache of owner listings, keyed by owner and
+// repository filter
+type repoCache struct {
+	Entries map[string]repoCacheEntry `json:"entries"`
+}
+
+// repoCacheEntry is one owner listing and when it was fetched
+type repoCacheEntry struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Repos     []string  `json:"repos"`
+}
+
+// SetTestRepoCacheDir sets the directory owner listings are cached in, for
+// testing. An empty dir restores the user cache directory.
+func SetTestRepoCacheDir(dir string) {
+	repoCacheMu.Lock()
+	repoCacheDir = dir
+	repoCacheMu.Unlock()
+}
+
+// HasRepositoryPatterns reports whether any repositories entry is a glob
+// such as owner/* that ExpandRepositories would expand
+func (c *Config) HasRepositoryPatterns() bool {
+	for _, repo := range c.Repositories {
+		if isRepositoryPattern(repo) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandRepositories replaces glob entries such as owner/* or owner/api-*
+// with the owner's matching repositories, listed through list. Expansions
+// keep the position of their pattern, are sorted by name, and skip
+// repositories already listed. A pattern matching nothing is an error.
+func (c *Config) ExpandRepositories(list RepoLister) error {
+	if !c.HasRepositoryPatterns() {
+		return nil
+	}
+
+	expanded := make([]string, 0, len(c.Repositories))
+	seen := make(map[string]bool)
+	add := func(repo string) {
+		if !seen[repo] {
+			seen[repo] = true
+			expanded = append(expanded, repo)
+		}
+	}
+	// Explicit entries win their place even when a pattern comes first
+	for _, repo := range c.Repositories {
+		if !isRepositoryPattern(repo) {
+			seen[repo] = true
+		}
+	}
+
+	for _, entry := range c.Repositories {
+		if !isRepositoryPattern(entry) {
+			expanded = append(expanded, entry)
+			continue
+		}
+		owner, pattern, ok := strings.Cut(entry, "/")
+		if !ok || strings.ContainsAny(owner, "*?[") {
+			return fmt.Errorf("repositories: invalid pattern %q: only the repository name may contain wildcards", entry)
+		}
+		repos, err := c.listOwnerRepos(owner, list)
+		if err != nil {
+			return fmt.Errorf("repositories: failed to expand %s: %w", entry, err)
+		}
+		var matches []string
+		for _, repo := range repos {
+			_, name, _ := strings.Cut(repo, "/")
+			if matched, err := path.Match(pattern, name); err != nil {
+				return fmt.Errorf("repositories: invalid pattern %q: %w", entry, err)
+			} else if matched {
+				matches = append(matches, repo)
+			}
+		}
+		if len(matches) == 0 {
+			return fmt.Errorf("repositories: %s matched no repositories", entry)
+		}
+		sort.Strings(matches)
+		for _, repo := range matches {
+			add(repo)
+		}
+	}
+
+	if c.repositoryPatterns == nil {
+		c.repositoryPatterns = c.Repositories
+	}
+	c.Repositories = expanded
+	return nil
+}
+
+// listOwnerRepos returns the owner's repositories from the on-disk cache,
+// listing them when there is no entry for the owner and repository filter
+// younger than repoCacheTTL. The cache is best effort: when it cannot be read
+// or written the owner is simply listed.
+func (c *Config) listOwnerRepos(owner string, list RepoLister) ([]string, error) {
+	key := c.repoCacheKey(owner)
+	repoCacheMu.Lock()
+	path := repoCachePath()
+	cache := readRepoCache(path)
+	repoCacheMu.Unlock()
+	if entry, ok := cache.Entries[key]; ok && time.Since(entry.FetchedAt) < repoCacheTTL {
+		return entry.Repos, nil
+	}
+
+	repos, err := list(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	repoCacheMu.Lock()
+	defer repoCacheMu.Unlock()
+	if path == "" {
+		return repos, nil
+	}
+	// Re-read so entries written meanwhile are kept
+	cache = readRepoCache(path)
+	for k, entry := range cache.Entries {
+		if time.Since(entry.FetchedAt) >= repoCacheTTL {
+			delete(cache.Entries, k)
+		}
+	}
+	cache.Entries[key] = repoCacheEntry{FetchedAt: time.Now(), Repos: repos}
+	writeRepoCache(path, cache)
+	return repos, nil
+}
+
+// repoCacheKey identifies an owner listing: the owner plus the filter
+// settings that narrowed it
+func (c *Config) repoCacheKey(owner string) string {
+	var topics []string
+	includeArchived := false
+	if c.RepositoryFilter != nil {
+		topics = append(topics, c.RepositoryFilter.Topics...)
+		includeArchived = c.RepositoryFilter.IncludeArchived
+	}
+	sort.Strings(topics)
+	return fmt.Sprintf("%s topics=%s archived=%t", strings.ToLower(owner), strings.Join(topics, ","), includeArchived)
+}
+
+// repoCachePath returns the cache file path, or "" when there is no cache
+// directory
+func repoCachePath() string {
+	dir := repoCacheDir
+	if dir == "" {
+		userDir, err := os.UserCacheDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(userDir, "gh-pmu")
+	}
+	return filepath.Join(dir, repoCacheFile)
+}
+
+// readRepoCache loads the cache file, returning an empty cache when it is
+// missing or unreadable
+func readRepoCache(path string) repoCache {
+	cache := repoCache{Entries: make(map[string]repoCacheEntry)}
+	if path == "" {
+		return cache
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, &cache); err != nil || cache.Entries == nil {
+		return repoCache{Entries: make(map[string]repoCacheEntry)}
+	}
+	return cache
+}
+
+// writeRepoCache replaces the cache file through a temporary file, so a
+// concurrent reader never sees a partial write. Failures are ignored.
+func writeRepoCache(path string, cache repoCache) {
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), repoCacheFile+".*")
+	if err != nil {
+		return
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil || os.Rename(tmp.Name(), path) != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// isRepositoryPattern reports whether a repositories entry contains a glob
+func isRepositoryPattern(repo string) bool {
+	return strings.ContainsAny(repo, "*?[")
+}
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// resetRepoListCache points the listing cache at an empty directory for the
+// test and returns it
+func resetRepoListCache(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	SetTestRepoCacheDir(dir)
+	t.Cleanup(func() { SetTestRepoCacheDir("") })
+	return dir
+}
+
+// countingLister returns repos for any owner and counts the listings
+func countingLister(calls *int, repos ...string) RepoLister {
+	return func(owner string) ([]string, error) {
+		*calls++
+		return repos, nil
+	}
+}
+
+func TestExpandRepositories_OwnerWildcard(t *testing.T) {
+	resetRepoListCache(t)
+	calls := 0
+	lister := countingLister(&calls, "acme/web", "acme/api", "acme/cli")
+	cfg := &Config{Repositories: []string{"acme/cli", "acme/*", "other/tool"}}
+
+	if err := cfg.ExpandRepositories(lister); err != nil {
+		t.Fatalf("ExpandRepositories failed: %v", err)
+	}
+
+	// The explicit entry keeps its place; the expansion is sorted without it
+	want := []string{"acme/cli", "acme/api", "acme/web", "other/tool"}
+	if !reflect.DeepEqual(cfg.Repositories, want) {
+		t.Errorf("Expected %v, got %v", want, cfg.Repositories)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 listing, got %d", calls)
+	}
+}
+
+func TestExpandRepositories_CachesListingPerOwner(t *testing.T) {
+	resetRepoListCache(t)
+	calls := 0
+	lister := countingLister(&calls, "acme/api", "acme/api-gateway", "acme/web")
+
+	first := &Config{Repositories: []string{"acme/*"}}
+	second := &Config{Repositories: []string{"acme/api*"}}
+	if err := first.ExpandRepositories(lister); err != nil {
+		t.Fatalf("ExpandRepositories failed: %v", err)
+	}
+	if err := second.ExpandRepositories(lister); err != nil {
+		t.Fatalf("ExpandRepositories failed: %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected the owner to be listed once, got %d listings", calls)
+	}
+	if _, err := os.Stat(filepath.Join(repoCacheDir, repoCacheFile)); err != nil {
+		t.Errorf("Expected the listing on disk, got: %v", err)
+	}
+	if want := []string{"acme/api", "acme/api-gateway"}; !reflect.DeepEqual(second.Repositories, want) {
+		t.Errorf("Expected %v from the cached listing, got %v", want, second.Repositories)
+	}
+}
+
+func TestExpandRepositories_CacheKeyedByFilter(t *testing.T) {
+	resetRepoListCache(t)
+	calls := 0
+	lister := countingLister(&calls, "acme/api")
+
+	plain := &Config{Repositories: []string{"acme/*"}}
+	archived := &Config{Repositories: []string{"acme/*"}, RepositoryFilter: &RepositoryFilter{IncludeArchived: true}}
+	tagged := &Config{Repositories: []string{"acme/*"}, RepositoryFilter: &RepositoryFilter{Topics: []string{"service"}}}
+	for _, cfg := range []*Config{plain, archived, tagged} {
+		if err := cfg.ExpandRepositories(lister); err != nil {
+			t.Fatalf("ExpandRepositories failed: %v", err)
+		}
+	}
+
+	if calls != 3 {
+		t.Errorf("Expected one listing per filter, got %d listings", calls)
+	}
+}
+
+func TestExpandRepositories_ExpiredCacheIsRelisted(t *testing.T) {
+	// ARRANGE: a listing fetched longer ago than the TTL
+	dir := resetRepoListCache(t)
+	stale := &Config{}
+	cache := repoCache{Entries: map[string]repoCacheEntry{
+		stale.repoCacheKey("acme"): {FetchedAt: time.Now().Add(-2 * repoCacheTTL), Repos: []string{"acme/old"}},
+	}}
+	data, _ := json.Marshal(cache)
+	if err := os.WriteFile(filepath.Join(dir, repoCacheFile), data, 0644); err != nil {
+		t.Fatalf("Failed to write cache: %v", err)
+	}
+	calls := 0
+	cfg := &Config{Repositories: []string{"acme/*"}}
+
+	// ACT
+	err := cfg.ExpandRepositories(countingLister(&calls, "acme/new"))
+
+	// ASSERT
+	if err != nil {
+		t.Fatalf("ExpandRepositories failed: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected the expired listing to be refreshed, got %d listings", calls)
+	}
+	if want := []string{"acme/new"}; !reflect.DeepEqual(cfg.Repositories, want) {
+		t.Errorf("Expected %v, got %v", want, cfg.Repositories)
+	}
+}
+
+func TestExpandRepositories_NoMatchesIsAnError(t *testing.T) {
+	resetRepoListCache(t)
+	calls := 0
+	cfg := &Config{Repositories: []string{"empty-org/*"}}
+
+	err := cfg.ExpandRepositories(countingLister(&calls))
+
+	if err == nil || !strings.Contains(err.Error(), "empty-org/* matched no repositories") {
+		t.Errorf("Expected no-match error, got: %v", err)
+	}
+}
+
+func TestExpandRepositories_WildcardOwnerRejected(t *testing.T) {
+	resetRepoListCache(t)
+	calls := 0
+	cfg := &Config{Repositories: []string{"*/api"}}
+
+	err := cfg.ExpandRepositories(countingLister(&calls, "acme/api"))
+
+	if err == nil || !strings.Contains(err.Error(), "only the repository name may contain wildcards") {
+		t.Errorf("Expected invalid pattern error, got: %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("Expected no listing, got %d", calls)
+	}
+}
+
+func TestExpandRepositories_SaveKeepsPattern(t *testing.T) {
+	resetRepoListCache(t)
+	calls := 0
+	configPath := filepath.Join(t.TempDir(), ".gh-pmu.yml")
+	cfg := &Config{
+		Project:      Project{Owner: "acme", Number: 1},
+		Repositories: []string{"acme/*"},
+	}
+	if err := cfg.ExpandRepositories(countingLister(&calls, "acme/api", "acme/web")); err != nil {
+		t.Fatalf("ExpandRepositories failed: %v", err)
+	}
+
+	if err := cfg.Save(configPath); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if want := []string{"acme/*"}; !reflect.DeepEqual(loaded.Repositories, want) {
+		t.Errorf("Expected the pattern to be saved, got %v", loaded.Repositories)
+	}
+	if len(cfg.Repositories) != 2 {
+		t.Errorf("Expected the expansion to remain active after Save, got %v", cfg.Repositories)
+	}
+}